@@ -0,0 +1,355 @@
+package beater
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/elastic/beats/v7/libbeat/api"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/yukshimizu/pulsarbeat/config"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// The endpoints are served by the beat's HTTP server, which is created before
+// the beater and copies the registered handlers when it is created. They are
+// therefore registered on init and act on the running beater. Unless
+// pulsarbeat.api.allow_remote is set, they only serve requests from the local
+// host, either over a loopback address or a unix socket.
+var handlers = map[string]func(http.ResponseWriter, *http.Request){
+	"/pulsarbeat/consumers":            handleConsumers,
+	"/pulsarbeat/consumers/pause":      handlePause,
+	"/pulsarbeat/consumers/resume":     handleResume,
+	"/pulsarbeat/consumers/seek":       handleSeek,
+	"/pulsarbeat/consumers/rate_limit": handleRateLimit,
+}
+
+func init() {
+	for path, handler := range handlers {
+		if err := api.AddHandlerFunc(path, local(handler)); err != nil {
+			logp.Err("Error adding API handler %s: %v", path, err)
+		}
+	}
+}
+
+// running holds the beater the endpoints act on.
+var running runningBeat
+
+type runningBeat struct {
+	mu sync.RWMutex
+	bt *pulsarbeat
+}
+
+func (r *runningBeat) set(bt *pulsarbeat) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.bt = bt
+}
+
+func (r *runningBeat) get() *pulsarbeat {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.bt
+}
+
+// workerRegistry holds the workers of all running inputs, so that they can be
+// listed and controlled through the API.
+type workerRegistry struct {
+	mu      sync.RWMutex
+	nextID  int
+	workers map[int]*worker
+}
+
+func (r *workerRegistry) add(w *worker) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.workers == nil {
+		r.workers = make(map[int]*worker)
+	}
+	r.nextID++
+	w.id = r.nextID
+	r.workers[w.id] = w
+}
+
+func (r *workerRegistry) remove(w *worker) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.workers, w.id)
+}
+
+func (r *workerRegistry) get(id int) (*worker, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	w, ok := r.workers[id]
+	return w, ok
+}
+
+func (r *workerRegistry) list() []*worker {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	workers := make([]*worker, 0, len(r.workers))
+	for _, w := range r.workers {
+		workers = append(workers, w)
+	}
+	sort.Slice(workers, func(i, j int) bool { return workers[i].id < workers[j].id })
+	return workers
+}
+
+// local rejects requests from remote hosts unless they are allowed.
+func local(handler func(http.ResponseWriter, *http.Request)) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		bt := running.get()
+		if bt != nil && !bt.config.API.AllowRemote && !isLocal(r.RemoteAddr) {
+			writeError(w, http.StatusForbidden, fmt.Errorf("remote access is not allowed"))
+			return
+		}
+		handler(w, r)
+	}
+}
+
+// isLocal reports whether addr is a loopback address. Requests over a unix
+// socket have no address of the host:port form and are local.
+func isLocal(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// handleRateLimit returns the rate limit of a consumer on GET and replaces it
+// with the limits of the request body on PUT, e.g.
+//
+//	curl -X PUT 'localhost:5066/pulsarbeat/consumers/rate_limit?id=1' -d '{"messages_per_second": 100}'
+//
+// The limit is shared by the workers of the consumer configuration the
+// consumer belongs to.
+func handleRateLimit(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodPut {
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return
+	}
+	worker, ok := lookupWorker(w, r)
+	if !ok {
+		return
+	}
+
+	if r.Method == http.MethodPut {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		cfg, err := common.NewConfigFrom(string(body))
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		var settings config.RateLimit
+		if err := cfg.Unpack(&settings); err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		worker.rateLimiter.update(settings)
+		logp.Info("Rate limit of %s changed to %+v", worker.input, settings)
+	}
+	writeJSON(w, http.StatusOK, rateLimitState(worker.rateLimiter.current()))
+}
+
+// handleConsumers lists the consumers of all inputs with their state and
+// counters, e.g.
+//
+//	curl localhost:5066/pulsarbeat/consumers
+func handleConsumers(w http.ResponseWriter, r *http.Request) {
+	bt := running.get()
+	if bt == nil {
+		writeError(w, http.StatusServiceUnavailable, fmt.Errorf("pulsarbeat is not running"))
+		return
+	}
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return
+	}
+
+	consumers := []common.MapStr{}
+	for _, worker := range bt.workers.list() {
+		consumers = append(consumers, workerState(worker))
+	}
+	writeJSON(w, http.StatusOK, common.MapStr{"consumers": consumers})
+}
+
+// handlePause stops a consumer from receiving messages, e.g.
+//
+//	curl -X POST 'localhost:5066/pulsarbeat/consumers/pause?id=1'
+func handlePause(w http.ResponseWriter, r *http.Request) {
+	withWorker(w, r, func(worker *worker) error {
+		worker.pause()
+		logp.Info("Paused consumer %d of %s", worker.id, worker.input)
+		return nil
+	})
+}
+
+// handleResume lets a paused consumer receive messages again, e.g.
+//
+//	curl -X POST 'localhost:5066/pulsarbeat/consumers/resume?id=1'
+func handleResume(w http.ResponseWriter, r *http.Request) {
+	withWorker(w, r, func(worker *worker) error {
+		worker.resume()
+		logp.Info("Resumed consumer %d of %s", worker.id, worker.input)
+		return nil
+	})
+}
+
+// handleSeek resets the subscription of a consumer to a timestamp or to a
+// message ID of the form ledger:entry[:partition[:batch]], e.g.
+//
+//	curl -X POST 'localhost:5066/pulsarbeat/consumers/seek?id=1' -d '{"timestamp": "2021-06-01T12:00:00Z"}'
+func handleSeek(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	var target struct {
+		Timestamp string `json:"timestamp"`
+		MessageID string `json:"message_id"`
+	}
+	if err := json.Unmarshal(body, &target); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var seek func(pulsar.Consumer) error
+	switch {
+	case target.Timestamp != "" && target.MessageID == "":
+		t, err := time.Parse(time.RFC3339Nano, target.Timestamp)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		seek = func(c pulsar.Consumer) error { return c.SeekByTime(t) }
+	case target.MessageID != "" && target.Timestamp == "":
+		id, err := parseMessageID(target.MessageID)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		seek = func(c pulsar.Consumer) error { return c.Seek(id) }
+	default:
+		writeError(w, http.StatusBadRequest, fmt.Errorf("either timestamp or message_id is required"))
+		return
+	}
+
+	withWorker(w, r, func(worker *worker) error {
+		if err := seek(worker.consumer); err != nil {
+			return err
+		}
+		logp.Info("Consumer %d of %s seeked to %s%s", worker.id, worker.input, target.Timestamp, target.MessageID)
+		return nil
+	})
+}
+
+// withWorker applies action to the worker identified by the id parameter of
+// the POST request r and responds with the state of the worker.
+func withWorker(w http.ResponseWriter, r *http.Request, action func(*worker) error) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return
+	}
+	worker, ok := lookupWorker(w, r)
+	if !ok {
+		return
+	}
+	if err := action(worker); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, workerState(worker))
+}
+
+// lookupWorker returns the worker identified by the id parameter of r, or
+// responds with an error.
+func lookupWorker(w http.ResponseWriter, r *http.Request) (*worker, bool) {
+	bt := running.get()
+	if bt == nil {
+		writeError(w, http.StatusServiceUnavailable, fmt.Errorf("pulsarbeat is not running"))
+		return nil, false
+	}
+	id, err := strconv.Atoi(r.URL.Query().Get("id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("invalid consumer id: %v", err))
+		return nil, false
+	}
+	worker, ok := bt.workers.get(id)
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Errorf("consumer %d not found", id))
+		return nil, false
+	}
+	return worker, true
+}
+
+func workerState(w *worker) common.MapStr {
+	state := "running"
+	if w.paused() {
+		state = "paused"
+	}
+	return common.MapStr{
+		"id":           w.id,
+		"input":        w.input,
+		"name":         w.consumer.Name(),
+		"subscription": w.consumer.Subscription(),
+		"state":        state,
+		"received":     atomic.LoadInt64(&w.received),
+		"acknowledged": atomic.LoadInt64(&w.acked),
+		"in_flight":    w.pending(),
+		"rate_limit":   rateLimitState(w.rateLimiter.current()),
+	}
+}
+
+func rateLimitState(settings config.RateLimit) common.MapStr {
+	return common.MapStr{
+		"messages_per_second": settings.MessagesPerSecond,
+		"messages_burst":      settings.MessagesBurst,
+		"bytes_per_second":    settings.BytesPerSecond,
+		"bytes_burst":         settings.BytesBurst,
+	}
+}
+
+// parseMessageID parses the ledger:entry[:partition[:batch]] form of message
+// IDs as printed by the pulsar tools.
+func parseMessageID(s string) (pulsar.MessageID, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) < 2 || len(parts) > 4 {
+		return nil, fmt.Errorf("invalid message id: %s", s)
+	}
+	values := []int64{0, 0, -1, -1}
+	for i, part := range parts {
+		v, err := strconv.ParseInt(part, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid message id: %s", s)
+		}
+		values[i] = v
+	}
+	return pulsar.NewMessageID(values[0], values[1], int32(values[3]), int32(values[2])), nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		logp.Debug(selector, "writing API response failed: %v", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, common.MapStr{"error": err.Error()})
+}
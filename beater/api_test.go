@@ -0,0 +1,389 @@
+// +build !integration
+
+package beater
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/elastic/beats/v7/libbeat/api"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/monitoring"
+	"github.com/yukshimizu/pulsarbeat/config"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestAPIRoutes serves the endpoints like the beat does, with a server created
+// from the registered handlers before the beater exists.
+func TestAPIRoutes(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pulsarbeat")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	socket := filepath.Join(dir, "api.sock")
+
+	server, err := api.NewWithDefaultRoutes(logp.NewLogger(selector), common.MustNewConfigFrom(map[string]interface{}{
+		"host": "unix://" + socket,
+	}), monitoring.GetNamespace)
+	if err != nil {
+		t.Fatalf("Could not create API server: %v", err)
+	}
+	server.Start()
+	defer server.Stop()
+
+	bt := &pulsarbeat{}
+	bt.workers.add(&worker{consumer: newTestConsumer(), rateLimiter: newRateLimiter(config.RateLimit{})})
+	running.set(bt)
+	defer running.set(nil)
+
+	client := http.Client{Transport: &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, "unix", socket)
+		},
+	}}
+	for path := range handlers {
+		t.Run(path, func(t *testing.T) {
+			resp, err := client.Get("http://unix" + path + "?id=1")
+			if err != nil {
+				t.Fatalf("Request failed: %v", err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode == http.StatusNotFound {
+				t.Errorf("Expected %s to be served", path)
+			}
+			if ct := resp.Header.Get("Content-Type"); !strings.HasPrefix(ct, "application/json") {
+				t.Errorf("Expected a JSON response from %s, got %s", path, ct)
+			}
+		})
+	}
+}
+
+func TestInputFactoryRateLimit(t *testing.T) {
+	in, err := testInput(t, config.DefaultConfig)
+	if err != nil {
+		t.Fatalf("Could not create input: %v", err)
+	}
+	factory := &inputFactory{bt: in.bt}
+
+	cfg := common.MustNewConfigFrom(map[string]interface{}{
+		"topic":      "my-topic",
+		"rate_limit": map[string]interface{}{"messages_per_second": 10},
+	})
+	loaded, err := factory.newInput(nil, cfg)
+	if err != nil {
+		t.Fatalf("Could not create input: %v", err)
+	}
+	if rate := loaded.rateLimiter.current().MessagesPerSecond; rate != 10 {
+		t.Errorf("Expected the rate limit of the loaded input, got %v", rate)
+	}
+	if loaded.rateLimiter == in.rateLimiter {
+		t.Error("Expected each input to have its own rate limiter")
+	}
+}
+
+func TestHandleRateLimit(t *testing.T) {
+	bt := &pulsarbeat{}
+	first := &worker{consumer: newTestConsumer(), rateLimiter: newRateLimiter(config.RateLimit{})}
+	second := &worker{consumer: newTestConsumer(), rateLimiter: newRateLimiter(config.RateLimit{})}
+	bt.workers.add(first)
+	bt.workers.add(second)
+	running.set(bt)
+	defer running.set(nil)
+
+	cases := map[string]struct {
+		method string
+		url    string
+		body   string
+		status int
+		rate   float64
+	}{
+		"get": {
+			method: http.MethodGet,
+			url:    "/pulsarbeat/consumers/rate_limit?id=1",
+			status: http.StatusOK,
+		},
+		"put": {
+			method: http.MethodPut,
+			url:    "/pulsarbeat/consumers/rate_limit?id=1",
+			body:   `{"messages_per_second": 100}`,
+			status: http.StatusOK,
+			rate:   100,
+		},
+		"missing id": {
+			method: http.MethodGet,
+			url:    "/pulsarbeat/consumers/rate_limit",
+			status: http.StatusBadRequest,
+		},
+		"unknown id": {
+			method: http.MethodGet,
+			url:    "/pulsarbeat/consumers/rate_limit?id=3",
+			status: http.StatusNotFound,
+		},
+		"post": {
+			method: http.MethodPost,
+			url:    "/pulsarbeat/consumers/rate_limit?id=1",
+			status: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			first.rateLimiter.update(config.RateLimit{})
+			req := httptest.NewRequest(c.method, c.url, strings.NewReader(c.body))
+			rec := httptest.NewRecorder()
+			handleRateLimit(rec, req)
+
+			if rec.Code != c.status {
+				t.Fatalf("Expected status %d, got %d: %s", c.status, rec.Code, rec.Body)
+			}
+			if c.status != http.StatusOK {
+				return
+			}
+			var settings map[string]float64
+			if err := json.Unmarshal(rec.Body.Bytes(), &settings); err != nil {
+				t.Fatalf("Could not decode response: %v", err)
+			}
+			if rate := settings["messages_per_second"]; rate != c.rate {
+				t.Errorf("Expected rate %v in response, got %v", c.rate, rate)
+			}
+			if rate := first.rateLimiter.current().MessagesPerSecond; rate != c.rate {
+				t.Errorf("Expected rate %v of the consumer, got %v", c.rate, rate)
+			}
+			if rate := second.rateLimiter.current().MessagesPerSecond; rate != 0 {
+				t.Errorf("Expected other consumers to keep their rate, got %v", rate)
+			}
+		})
+	}
+}
+
+// testBeat runs a beater with two consumers for the handlers.
+func testBeat() (*pulsarbeat, []*testConsumer) {
+	bt := &pulsarbeat{}
+	var consumers []*testConsumer
+	for i := 0; i < 2; i++ {
+		consumer := newTestConsumer()
+		consumers = append(consumers, consumer)
+		bt.workers.add(&worker{
+			input:       "my-input",
+			consumer:    consumer,
+			rateLimiter: newRateLimiter(config.RateLimit{}),
+		})
+	}
+	running.set(bt)
+	return bt, consumers
+}
+
+func serve(handler func(http.ResponseWriter, *http.Request), method, url, body string) (*httptest.ResponseRecorder, common.MapStr) {
+	rec := httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(method, url, strings.NewReader(body)))
+	var response common.MapStr
+	json.Unmarshal(rec.Body.Bytes(), &response)
+	return rec, response
+}
+
+func TestHandleConsumers(t *testing.T) {
+	rec, _ := serve(handleConsumers, http.MethodGet, "/pulsarbeat/consumers", "")
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("Expected status %d without a running beat, got %d", http.StatusServiceUnavailable, rec.Code)
+	}
+
+	testBeat()
+	defer running.set(nil)
+
+	rec, response := serve(handleConsumers, http.MethodGet, "/pulsarbeat/consumers", "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d: %s", http.StatusOK, rec.Code, rec.Body)
+	}
+	consumers, _ := response["consumers"].([]interface{})
+	if len(consumers) != 2 {
+		t.Fatalf("Expected 2 consumers, got %v", response)
+	}
+	for i, c := range consumers {
+		state := c.(map[string]interface{})
+		if id := state["id"].(float64); int(id) != i+1 {
+			t.Errorf("Expected consumers ordered by id, got %v at %d", id, i)
+		}
+		if state["state"] != "running" || state["subscription"] != "my-subscription" || state["input"] != "my-input" {
+			t.Errorf("Unexpected state of consumer %d: %v", i+1, state)
+		}
+	}
+
+	rec, _ = serve(handleConsumers, http.MethodPost, "/pulsarbeat/consumers", "")
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("Expected status %d on POST, got %d", http.StatusMethodNotAllowed, rec.Code)
+	}
+}
+
+func TestHandlePauseResume(t *testing.T) {
+	bt, _ := testBeat()
+	defer running.set(nil)
+	first, _ := bt.workers.get(1)
+	second, _ := bt.workers.get(2)
+
+	tests := []struct {
+		name        string
+		handler     func(http.ResponseWriter, *http.Request)
+		method      string
+		url         string
+		status      int
+		wantPaused  bool
+		otherPaused bool
+	}{
+		{name: "pause", handler: handlePause, method: http.MethodPost, url: "/pulsarbeat/consumers/pause?id=1",
+			status: http.StatusOK, wantPaused: true},
+		{name: "pause again", handler: handlePause, method: http.MethodPost, url: "/pulsarbeat/consumers/pause?id=1",
+			status: http.StatusOK, wantPaused: true},
+		{name: "pause with GET", handler: handlePause, method: http.MethodGet, url: "/pulsarbeat/consumers/pause?id=1",
+			status: http.StatusMethodNotAllowed, wantPaused: true},
+		{name: "resume", handler: handleResume, method: http.MethodPost, url: "/pulsarbeat/consumers/resume?id=1",
+			status: http.StatusOK},
+		{name: "resume running", handler: handleResume, method: http.MethodPost, url: "/pulsarbeat/consumers/resume?id=1",
+			status: http.StatusOK},
+		{name: "pause invalid id", handler: handlePause, method: http.MethodPost, url: "/pulsarbeat/consumers/pause?id=one",
+			status: http.StatusBadRequest},
+		{name: "pause unknown id", handler: handlePause, method: http.MethodPost, url: "/pulsarbeat/consumers/pause?id=3",
+			status: http.StatusNotFound},
+	}
+
+	for _, test := range tests {
+		rec, response := serve(test.handler, test.method, test.url, "")
+		if rec.Code != test.status {
+			t.Fatalf("%s: expected status %d, got %d: %s", test.name, test.status, rec.Code, rec.Body)
+		}
+		if first.paused() != test.wantPaused {
+			t.Errorf("%s: expected paused %v, got %v", test.name, test.wantPaused, first.paused())
+		}
+		if second.paused() {
+			t.Errorf("%s: expected the other consumer to keep running", test.name)
+		}
+		if rec.Code == http.StatusOK {
+			want := "running"
+			if test.wantPaused {
+				want = "paused"
+			}
+			if response["state"] != want {
+				t.Errorf("%s: expected state %s in response, got %v", test.name, want, response["state"])
+			}
+		}
+	}
+}
+
+func TestHandleSeek(t *testing.T) {
+	_, consumers := testBeat()
+	defer running.set(nil)
+
+	timestamp := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		method string
+		body   string
+		status int
+		want   interface{}
+	}{
+		{name: "timestamp", method: http.MethodPost, body: `{"timestamp": "2021-06-01T12:00:00Z"}`,
+			status: http.StatusOK, want: timestamp},
+		{name: "message id", method: http.MethodPost, body: `{"message_id": "12:34"}`,
+			status: http.StatusOK, want: pulsar.NewMessageID(12, 34, -1, -1)},
+		{name: "both", method: http.MethodPost, body: `{"timestamp": "2021-06-01T12:00:00Z", "message_id": "12:34"}`,
+			status: http.StatusBadRequest},
+		{name: "neither", method: http.MethodPost, body: `{}`, status: http.StatusBadRequest},
+		{name: "invalid timestamp", method: http.MethodPost, body: `{"timestamp": "yesterday"}`,
+			status: http.StatusBadRequest},
+		{name: "invalid message id", method: http.MethodPost, body: `{"message_id": "12"}`,
+			status: http.StatusBadRequest},
+		{name: "invalid body", method: http.MethodPost, body: `timestamp`, status: http.StatusBadRequest},
+		{name: "GET", method: http.MethodGet, body: `{"message_id": "12:34"}`, status: http.StatusMethodNotAllowed},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			consumers[0].seekedTo = nil
+			rec, _ := serve(handleSeek, test.method, "/pulsarbeat/consumers/seek?id=1", test.body)
+			if rec.Code != test.status {
+				t.Fatalf("Expected status %d, got %d: %s", test.status, rec.Code, rec.Body)
+			}
+			if !reflect.DeepEqual(consumers[0].seekedTo, test.want) {
+				t.Errorf("Expected the consumer to seek to %v, got %v", test.want, consumers[0].seekedTo)
+			}
+			if consumers[1].seekedTo != nil {
+				t.Errorf("Expected the other consumer to keep its position, got %v", consumers[1].seekedTo)
+			}
+		})
+	}
+}
+
+func TestParseMessageID(t *testing.T) {
+	tests := []struct {
+		id      string
+		want    pulsar.MessageID
+		wantErr bool
+	}{
+		{id: "12:34", want: pulsar.NewMessageID(12, 34, -1, -1)},
+		{id: "12:34:2", want: pulsar.NewMessageID(12, 34, -1, 2)},
+		{id: "12:34:2:5", want: pulsar.NewMessageID(12, 34, 5, 2)},
+		{id: "12", wantErr: true},
+		{id: "12:34:2:5:1", wantErr: true},
+		{id: "12:x", wantErr: true},
+		{id: "12:34:-", wantErr: true},
+		{id: "", wantErr: true},
+	}
+
+	for _, test := range tests {
+		id, err := parseMessageID(test.id)
+		if (err != nil) != test.wantErr {
+			t.Errorf("%s: expected error %v, got %v", test.id, test.wantErr, err)
+			continue
+		}
+		if !test.wantErr && !reflect.DeepEqual(id, test.want) {
+			t.Errorf("%s: expected %v, got %v", test.id, test.want, id)
+		}
+	}
+}
+
+func TestLocal(t *testing.T) {
+	tests := []struct {
+		name        string
+		remoteAddr  string
+		allowRemote bool
+		status      int
+	}{
+		{name: "IPv4 loopback", remoteAddr: "127.0.0.1:50000", status: http.StatusOK},
+		{name: "IPv6 loopback", remoteAddr: "[::1]:50000", status: http.StatusOK},
+		{name: "unix socket", remoteAddr: "@", status: http.StatusOK},
+		{name: "remote", remoteAddr: "192.0.2.1:50000", status: http.StatusForbidden},
+		{name: "remote allowed", remoteAddr: "192.0.2.1:50000", allowRemote: true, status: http.StatusOK},
+		{name: "host name", remoteAddr: "localhost:50000", status: http.StatusForbidden},
+	}
+
+	handler := local(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, common.MapStr{})
+	})
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bt := &pulsarbeat{}
+			bt.config.API.AllowRemote = test.allowRemote
+			running.set(bt)
+			defer running.set(nil)
+
+			req := httptest.NewRequest(http.MethodGet, "/pulsarbeat/consumers", nil)
+			req.RemoteAddr = test.remoteAddr
+			rec := httptest.NewRecorder()
+			handler(rec, req)
+			if rec.Code != test.status {
+				t.Errorf("Expected status %d, got %d", test.status, rec.Code)
+			}
+		})
+	}
+}
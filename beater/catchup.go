@@ -0,0 +1,111 @@
+package beater
+
+import (
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"sort"
+	"sync"
+	"time"
+)
+
+// catchUp tracks the progress of the until_caught_up run mode. It holds the
+// last message ID of every topic partition at startup and is done once all of
+// them have been received or max messages have been received.
+type catchUp struct {
+	mu          sync.Mutex
+	remaining   map[string]pulsar.MessageID
+	maxMessages int64
+	received    int64
+	counts      map[string]int64
+	lastActive  time.Time
+	done        chan struct{}
+	finished    bool
+}
+
+func newCatchUp(consumers []pulsar.Consumer, maxMessages int64) (*catchUp, error) {
+	c := &catchUp{
+		remaining:   make(map[string]pulsar.MessageID),
+		maxMessages: maxMessages,
+		counts:      make(map[string]int64),
+		lastActive:  time.Now(),
+		done:        make(chan struct{}),
+	}
+	// all consumers of an input share the subscription, one of them is enough
+	ids, err := consumers[0].GetLastMessageIDs()
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		// a negative entry means the partition has no messages
+		if id.EntryID() >= 0 {
+			c.remaining[id.Topic()] = id
+			logp.Info("Consuming %s up to message %s", id.Topic(), id)
+		}
+	}
+	if len(c.remaining) == 0 {
+		c.finish()
+	}
+	return c, nil
+}
+
+// count records msg and reports whether it is to be processed, which is not
+// the case once max messages have been received.
+func (c *catchUp) count(msg pulsar.Message) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.maxMessages > 0 && c.received >= c.maxMessages {
+		return false
+	}
+	c.received++
+	c.counts[msg.Topic()]++
+	c.lastActive = time.Now()
+
+	if last, ok := c.remaining[msg.Topic()]; ok && !messageIDBefore(msg.ID(), last) {
+		delete(c.remaining, msg.Topic())
+		logp.Info("Caught up with %s", msg.Topic())
+	}
+	if len(c.remaining) == 0 || (c.maxMessages > 0 && c.received >= c.maxMessages) {
+		c.finish()
+	}
+	return true
+}
+
+// idleSince returns the time the last message was received, or the start time
+// if none was received yet.
+func (c *catchUp) idleSince() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lastActive
+}
+
+// summary returns the number of received messages by topic, sorted by topic.
+func (c *catchUp) summary() ([]string, map[string]int64, int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	topics := make([]string, 0, len(c.counts))
+	counts := make(map[string]int64, len(c.counts))
+	for topic, n := range c.counts {
+		topics = append(topics, topic)
+		counts[topic] = n
+	}
+	sort.Strings(topics)
+	return topics, counts, c.received
+}
+
+// finish must be called with the lock held or before c is shared.
+func (c *catchUp) finish() {
+	if !c.finished {
+		c.finished = true
+		close(c.done)
+	}
+}
+
+func messageIDBefore(a, b pulsar.MessageID) bool {
+	if a.LedgerID() != b.LedgerID() {
+		return a.LedgerID() < b.LedgerID()
+	}
+	if a.EntryID() != b.EntryID() {
+		return a.EntryID() < b.EntryID()
+	}
+	return a.BatchIdx() < b.BatchIdx()
+}
@@ -0,0 +1,222 @@
+// +build !integration
+
+package beater
+
+import (
+	"github.com/apache/pulsar-client-go/pulsar"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// topicMessageID is the last message ID of a topic partition.
+type topicMessageID struct {
+	pulsar.MessageID
+	topic string
+}
+
+func (id topicMessageID) Topic() string { return id.topic }
+
+func lastMessageIDs(ids map[string]pulsar.MessageID) []pulsar.TopicMessageID {
+	var last []pulsar.TopicMessageID
+	for topic, id := range ids {
+		last = append(last, topicMessageID{MessageID: id, topic: topic})
+	}
+	return last
+}
+
+func TestMessageIDBefore(t *testing.T) {
+	tests := []struct {
+		a, b pulsar.MessageID
+		want bool
+	}{
+		{a: pulsar.NewMessageID(1, 5, -1, 0), b: pulsar.NewMessageID(2, 0, -1, 0), want: true},
+		{a: pulsar.NewMessageID(2, 0, -1, 0), b: pulsar.NewMessageID(1, 5, -1, 0)},
+		{a: pulsar.NewMessageID(1, 4, -1, 0), b: pulsar.NewMessageID(1, 5, -1, 0), want: true},
+		{a: pulsar.NewMessageID(1, 5, -1, 0), b: pulsar.NewMessageID(1, 5, -1, 0)},
+		{a: pulsar.NewMessageID(1, 5, 0, 0), b: pulsar.NewMessageID(1, 5, 2, 0), want: true},
+		{a: pulsar.NewMessageID(1, 5, 2, 0), b: pulsar.NewMessageID(1, 5, 2, 0)},
+	}
+
+	for _, test := range tests {
+		if got := messageIDBefore(test.a, test.b); got != test.want {
+			t.Errorf("Expected %v before %v to be %v, got %v", test.a, test.b, test.want, got)
+		}
+	}
+}
+
+func TestCatchUpCount(t *testing.T) {
+	const (
+		topicA = "persistent://public/default/a-partition-0"
+		topicB = "persistent://public/default/b-partition-0"
+		empty  = "persistent://public/default/empty"
+	)
+	message := func(topic string, entry int64) pulsar.Message {
+		return &testMessage{topic: topic, id: pulsar.NewMessageID(1, entry, -1, 0)}
+	}
+
+	tests := []struct {
+		name        string
+		maxMessages int64
+		messages    []pulsar.Message
+		wantCounted int
+		wantDone    bool
+		wantCounts  map[string]int64
+	}{
+		{
+			name:        "Caught up with all topics",
+			messages:    []pulsar.Message{message(topicA, 1), message(topicB, 2), message(topicA, 2)},
+			wantCounted: 3,
+			wantDone:    true,
+			wantCounts:  map[string]int64{topicA: 2, topicB: 1},
+		},
+		{
+			name:        "Behind on a topic",
+			messages:    []pulsar.Message{message(topicA, 2), message(topicB, 1)},
+			wantCounted: 2,
+			wantCounts:  map[string]int64{topicA: 1, topicB: 1},
+		},
+		{
+			name:        "Max messages",
+			maxMessages: 2,
+			messages:    []pulsar.Message{message(topicA, 1), message(topicB, 1), message(topicA, 2)},
+			wantCounted: 2,
+			wantDone:    true,
+			wantCounts:  map[string]int64{topicA: 1, topicB: 1},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			consumer := newTestConsumer()
+			consumer.lastIDs = lastMessageIDs(map[string]pulsar.MessageID{
+				topicA: pulsar.NewMessageID(1, 2, -1, 0),
+				topicB: pulsar.NewMessageID(1, 2, -1, 0),
+				empty:  pulsar.NewMessageID(-1, -1, -1, 0),
+			})
+			c, err := newCatchUp([]pulsar.Consumer{consumer}, test.maxMessages)
+			if err != nil {
+				t.Fatalf("Could not create catch up: %v", err)
+			}
+			if _, ok := c.remaining[empty]; ok {
+				t.Error("Expected the empty partition to be caught up")
+			}
+
+			counted := 0
+			for _, msg := range test.messages {
+				if c.count(msg) {
+					counted++
+				}
+			}
+			if counted != test.wantCounted {
+				t.Errorf("Expected %d messages to be processed, got %d", test.wantCounted, counted)
+			}
+			select {
+			case <-c.done:
+				if !test.wantDone {
+					t.Error("Expected to be behind")
+				}
+			default:
+				if test.wantDone {
+					t.Error("Expected to be done")
+				}
+			}
+
+			topics, counts, total := c.summary()
+			if !reflect.DeepEqual(counts, test.wantCounts) {
+				t.Errorf("Expected counts %v, got %v", test.wantCounts, counts)
+			}
+			if total != int64(test.wantCounted) {
+				t.Errorf("Expected %d messages in total, got %d", test.wantCounted, total)
+			}
+			if len(topics) != 2 || topics[0] != topicA || topics[1] != topicB {
+				t.Errorf("Expected sorted topics, got %v", topics)
+			}
+		})
+	}
+}
+
+func TestNewCatchUpWithoutMessages(t *testing.T) {
+	consumer := newTestConsumer()
+	consumer.lastIDs = lastMessageIDs(map[string]pulsar.MessageID{
+		"persistent://public/default/empty": pulsar.NewMessageID(-1, -1, -1, 0),
+	})
+	c, err := newCatchUp([]pulsar.Consumer{consumer}, 0)
+	if err != nil {
+		t.Fatalf("Could not create catch up: %v", err)
+	}
+	select {
+	case <-c.done:
+	default:
+		t.Error("Expected topics without messages to be caught up right away")
+	}
+}
+
+func TestRunUntilCaughtUp(t *testing.T) {
+	tests := []struct {
+		name        string
+		caughtUp    bool
+		maxDuration time.Duration
+		idleTimeout time.Duration
+		stop        bool
+		minDuration time.Duration
+	}{
+		{name: "Caught up", caughtUp: true},
+		{name: "Max duration", maxDuration: 50 * time.Millisecond, minDuration: 50 * time.Millisecond},
+		{name: "Idle timeout", idleTimeout: time.Millisecond, minDuration: time.Second},
+		{name: "Stopped", stop: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			consumer := newTestConsumer()
+			consumer.lastIDs = lastMessageIDs(map[string]pulsar.MessageID{
+				"persistent://public/default/a": pulsar.NewMessageID(1, 2, -1, 0),
+			})
+			progress, err := newCatchUp([]pulsar.Consumer{consumer}, 0)
+			if err != nil {
+				t.Fatalf("Could not create catch up: %v", err)
+			}
+			if test.caughtUp {
+				progress.count(&testMessage{topic: "persistent://public/default/a", id: pulsar.NewMessageID(1, 2, -1, 0)})
+			}
+
+			bt := &pulsarbeat{done: make(chan struct{}), progress: progress}
+			bt.config.MaxDuration = test.maxDuration
+			bt.config.IdleTimeout = test.idleTimeout
+			// a message waits for the output
+			w := &worker{consumer: consumer, inflight: newInflightLimiter(0)}
+			atomic.StoreInt64(&w.received, 1)
+			bt.workers.add(w)
+			if test.stop {
+				close(bt.done)
+			}
+
+			start := time.Now()
+			finished := make(chan struct{})
+			go func() {
+				defer close(finished)
+				bt.runUntilCaughtUp()
+			}()
+
+			if !test.stop {
+				waitFor(t, "the workers to be paused", w.paused)
+				select {
+				case <-finished:
+					t.Fatal("Expected to wait for the output")
+				case <-time.After(50 * time.Millisecond):
+				}
+				w.ack(&testMessage{})
+			}
+			select {
+			case <-finished:
+			case <-time.After(10 * time.Second):
+				t.Fatal("Timed out waiting for runUntilCaughtUp")
+			}
+			if elapsed := time.Since(start); elapsed < test.minDuration {
+				t.Errorf("Expected to run at least %v, returned after %v", test.minDuration, elapsed)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package beater
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"strings"
+	"time"
+)
+
+const (
+	cloudEventsPropertyPrefix = "ce_"
+	cloudEventsContentType    = "application/cloudevents+json"
+	contentTypeProperty       = "content-type"
+)
+
+var errMalformedCloudEvent = errors.New("malformed structured CloudEvent")
+
+// cloudEventsToECS maps CloudEvents attributes to the event fields of ECS. The
+// subject is not mapped to event.reference, which ECS defines as a URL.
+var cloudEventsToECS = map[string]string{
+	"id":     "event.id",
+	"source": "event.provider",
+	"type":   "event.action",
+}
+
+// cloudEventsCodec recognises CloudEvents in binary mode, where the attributes
+// are message properties prefixed with ce_ and the payload is the data, and in
+// structured mode, where the payload is the JSON encoded event. The attributes
+// are kept in the cloudevents fields and id, source, type and time are mapped
+// to ECS. Other messages are passed through like the plain codec does.
+type cloudEventsCodec struct {
+	dataTarget string
+}
+
+func newCloudEventsCodec(cfg *common.Config) (codec, error) {
+	settings := struct {
+		DataTarget string `config:"data_target"`
+	}{DataTarget: "cloudevents.data"}
+	if err := cfg.Unpack(&settings); err != nil {
+		return nil, err
+	}
+	return &cloudEventsCodec{dataTarget: settings.DataTarget}, nil
+}
+
+func (c *cloudEventsCodec) decode(msg pulsar.Message, payload []byte) ([]beat.Event, error) {
+	properties := msg.Properties()
+	contentType := properties[contentTypeProperty]
+
+	if _, ok := properties[cloudEventsPropertyPrefix+"specversion"]; ok {
+		attributes := common.MapStr{}
+		for k, v := range properties {
+			if strings.HasPrefix(k, cloudEventsPropertyPrefix) {
+				attributes[strings.TrimPrefix(k, cloudEventsPropertyPrefix)] = v
+			}
+		}
+		if _, ok := attributes["datacontenttype"]; !ok && contentType != "" {
+			attributes["datacontenttype"] = contentType
+		}
+		return c.event(attributes, decodeCloudEventsData(attributes, payload)), nil
+	}
+
+	if strings.HasPrefix(contentType, cloudEventsContentType) || looksLikeJSONObject(payload) {
+		var structured map[string]interface{}
+		if err := json.Unmarshal(payload, &structured); err == nil && isCloudEvent(structured) {
+			var data interface{}
+			if encoded, ok := structured["data_base64"].(string); ok {
+				raw, err := base64.StdEncoding.DecodeString(encoded)
+				if err != nil {
+					return nil, err
+				}
+				data = decodeCloudEventsData(structured, raw)
+			} else {
+				data = structured["data"]
+			}
+			delete(structured, "data")
+			delete(structured, "data_base64")
+			return c.event(structured, data), nil
+		}
+		if strings.HasPrefix(contentType, cloudEventsContentType) {
+			return nil, errMalformedCloudEvent
+		}
+	}
+
+	return plainCodec{}.decode(msg, payload)
+}
+
+func (c *cloudEventsCodec) event(attributes common.MapStr, data interface{}) []beat.Event {
+	event := beat.Event{
+		Fields: common.MapStr{
+			"cloudevents": attributes,
+		},
+	}
+	for attribute, field := range cloudEventsToECS {
+		if value, ok := attributes[attribute]; ok && value != nil {
+			event.Fields.Put(field, value)
+		}
+	}
+	if t, ok := attributes["time"].(string); ok {
+		if ts, err := time.Parse(time.RFC3339Nano, t); err == nil {
+			event.Timestamp = ts
+		}
+	}
+	if data != nil {
+		event.Fields.Put(c.dataTarget, data)
+	}
+	return []beat.Event{event}
+}
+
+// decodeCloudEventsData decodes JSON data into an object and keeps any other
+// data as string.
+func decodeCloudEventsData(attributes map[string]interface{}, data []byte) interface{} {
+	if len(data) == 0 {
+		return nil
+	}
+	contentType, _ := attributes["datacontenttype"].(string)
+	if contentType == "application/json" || strings.HasSuffix(contentType, "+json") {
+		var decoded interface{}
+		if err := json.Unmarshal(data, &decoded); err == nil {
+			return decoded
+		}
+	}
+	return string(data)
+}
+
+func isCloudEvent(structured map[string]interface{}) bool {
+	for _, attribute := range []string{"specversion", "id", "source", "type"} {
+		if _, ok := structured[attribute].(string); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func looksLikeJSONObject(payload []byte) bool {
+	return bytes.HasPrefix(bytes.TrimSpace(payload), []byte("{"))
+}
@@ -0,0 +1,167 @@
+// +build !integration
+
+package beater
+
+import (
+	"github.com/elastic/beats/v7/libbeat/common"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCloudEventsCodec(t *testing.T) {
+	tests := []struct {
+		name       string
+		properties map[string]string
+		payload    string
+		wantFields common.MapStr
+		wantTime   time.Time
+		wantErr    bool
+	}{
+		{
+			name: "binary mode",
+			properties: map[string]string{
+				"ce_specversion": "1.0",
+				"ce_id":          "A234-1234-1234",
+				"ce_source":      "/orders",
+				"ce_type":        "com.example.order.created",
+				"ce_subject":     "order-42",
+				"ce_time":        "2021-06-01T12:00:00Z",
+				"content-type":   "application/json",
+				"other":          "ignored",
+			},
+			payload: `{"amount": 9.5}`,
+			wantFields: common.MapStr{
+				"cloudevents": common.MapStr{
+					"specversion":     "1.0",
+					"id":              "A234-1234-1234",
+					"source":          "/orders",
+					"type":            "com.example.order.created",
+					"subject":         "order-42",
+					"time":            "2021-06-01T12:00:00Z",
+					"datacontenttype": "application/json",
+					"data":            map[string]interface{}{"amount": 9.5},
+				},
+				"event": common.MapStr{
+					"id":       "A234-1234-1234",
+					"provider": "/orders",
+					"action":   "com.example.order.created",
+				},
+			},
+			wantTime: time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "binary mode without optional attributes",
+			properties: map[string]string{
+				"ce_specversion": "1.0",
+				"ce_source":      "/orders",
+			},
+			payload: "created",
+			wantFields: common.MapStr{
+				"cloudevents": common.MapStr{
+					"specversion": "1.0",
+					"source":      "/orders",
+					"data":        "created",
+				},
+				"event": common.MapStr{
+					"provider": "/orders",
+				},
+			},
+		},
+		{
+			name: "binary mode without data",
+			properties: map[string]string{
+				"ce_specversion": "1.0",
+			},
+			wantFields: common.MapStr{
+				"cloudevents": common.MapStr{
+					"specversion": "1.0",
+				},
+			},
+		},
+		{
+			name:       "structured mode",
+			properties: map[string]string{"content-type": "application/cloudevents+json; charset=utf-8"},
+			payload: `{"specversion": "1.0", "id": "1", "source": "/orders", "type": "created",
+				"datacontenttype": "application/json", "data": {"amount": 9.5}}`,
+			wantFields: common.MapStr{
+				"cloudevents": common.MapStr{
+					"specversion":     "1.0",
+					"id":              "1",
+					"source":          "/orders",
+					"type":            "created",
+					"datacontenttype": "application/json",
+					"data":            map[string]interface{}{"amount": 9.5},
+				},
+				"event": common.MapStr{
+					"id":       "1",
+					"provider": "/orders",
+					"action":   "created",
+				},
+			},
+		},
+		{
+			name: "structured mode with base64 data",
+			payload: `{"specversion": "1.0", "id": "1", "source": "/orders", "type": "created",
+				"subject": "order-42", "datacontenttype": "text/plain", "data_base64": "Y3JlYXRlZA=="}`,
+			wantFields: common.MapStr{
+				"cloudevents": common.MapStr{
+					"specversion":     "1.0",
+					"id":              "1",
+					"source":          "/orders",
+					"type":            "created",
+					"subject":         "order-42",
+					"datacontenttype": "text/plain",
+					"data":            "created",
+				},
+				"event": common.MapStr{
+					"id":       "1",
+					"provider": "/orders",
+					"action":   "created",
+				},
+			},
+		},
+		{
+			name:       "malformed structured mode",
+			properties: map[string]string{"content-type": "application/cloudevents+json"},
+			payload:    `{"specversion": "1.0"}`,
+			wantErr:    true,
+		},
+		{
+			name:       "passthrough of plain text",
+			payload:    "hello",
+			wantFields: common.MapStr{"message": "hello"},
+		},
+		{
+			name:       "passthrough of other JSON",
+			payload:    `{"id": "1"}`,
+			wantFields: common.MapStr{"message": `{"id": "1"}`},
+		},
+	}
+
+	c, err := newCloudEventsCodec(common.NewConfig())
+	if err != nil {
+		t.Fatalf("Could not create codec: %v", err)
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			msg := &testMessage{properties: test.properties, payload: []byte(test.payload)}
+			events, err := c.decode(msg, msg.payload)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if test.wantErr {
+				return
+			}
+			if len(events) != 1 {
+				t.Fatalf("Expected 1 event, got %d", len(events))
+			}
+			if !reflect.DeepEqual(events[0].Fields, test.wantFields) {
+				t.Errorf("Expected fields\n%v\ngot\n%v", test.wantFields.StringToPrint(), events[0].Fields.StringToPrint())
+			}
+			if !events[0].Timestamp.Equal(test.wantTime) {
+				t.Errorf("Expected timestamp %v, got %v", test.wantTime, events[0].Timestamp)
+			}
+		})
+	}
+}
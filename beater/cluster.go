@@ -0,0 +1,197 @@
+package beater
+
+import (
+	"fmt"
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/apache/pulsar-client-go/pulsaradmin"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/yukshimizu/pulsarbeat/config"
+	"sync"
+)
+
+// cluster is a pulsar cluster consumers subscribe at. It is named by the name
+// of its client settings, which events are tagged with as pulsar.cluster.
+type cluster struct {
+	name         string
+	connect      func(serviceURL string) (*pulsar.Client, error)
+	connectAdmin func() (pulsaradmin.Client, error)
+	failover     *failover
+	credentials  *credentialsWatcher
+
+	// switchMu serializes switches of the service URL and reconnects
+	switchMu sync.Mutex
+
+	mu         sync.Mutex
+	client     *pulsar.Client
+	admin      pulsaradmin.Client
+	serviceURL string
+
+	// inputs holds the running inputs subscribed at the cluster, which are
+	// resubscribed when the service URL is switched. Inputs which could not be
+	// resubscribed are held in failed until retryFailed restarts them.
+	inputsMu sync.Mutex
+	inputs   map[*input]struct{}
+	failed   map[*input]struct{}
+}
+
+// newClusters creates the clients of all client settings of c.
+func newClusters(c config.Config) (map[string]*cluster, error) {
+	clients, err := c.NamedClients()
+	if err != nil {
+		return nil, fmt.Errorf("error reading clients: %v", err)
+	}
+
+	clusters := make(map[string]*cluster, len(clients))
+	for name, clientOptions := range clients {
+		clientOptions := clientOptions
+		cl := &cluster{
+			name: name,
+			connect: func(serviceURL string) (*pulsar.Client, error) {
+				options := clientOptions
+				options.URL = serviceURL
+				return config.NewPulsarClient(options)
+			},
+			connectAdmin: func() (pulsaradmin.Client, error) {
+				return config.NewPulsarAdmin(clientOptions)
+			},
+			serviceURL: clientOptions.URL,
+			inputs:     make(map[*input]struct{}),
+		}
+
+		cl.client, err = cl.connect(clientOptions.URL)
+		if err != nil {
+			closeClusters(clusters)
+			return nil, fmt.Errorf("error creating pulsar client %s: %v", name, err)
+		}
+		clusters[name] = cl
+
+		cl.admin, err = cl.connectAdmin()
+		if err != nil {
+			closeClusters(clusters)
+			return nil, fmt.Errorf("error creating pulsar admin client %s: %v", name, err)
+		}
+
+		if len(clientOptions.Failover.Secondaries) != 0 {
+			cl.failover = newFailover(cl, clientOptions.URL, clientOptions.Failover.Secondaries,
+				clientOptions.Failover.ProbeInterval, clientOptions.Failover.SwitchBackDelay,
+				clientOptions.ConnectionTimeout)
+		}
+		cl.credentials = newCredentialsWatcher(cl, clientOptions.CredentialFiles(),
+			clientOptions.CredentialsReloadInterval)
+	}
+	return clusters, nil
+}
+
+func closeClusters(clusters map[string]*cluster) {
+	for _, c := range clusters {
+		(*c.current()).Close()
+		logp.Debug(selector, "pulsar client %s Closed!", c.name)
+	}
+}
+
+// current returns the client of the active service URL.
+func (c *cluster) current() *pulsar.Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.client
+}
+
+// currentAdmin returns the admin client, which always addresses admin_url.
+func (c *cluster) currentAdmin() pulsaradmin.Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.admin
+}
+
+func (c *cluster) activeURL() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.serviceURL
+}
+
+func (c *cluster) unregister(in *input) {
+	c.inputsMu.Lock()
+	defer c.inputsMu.Unlock()
+	delete(c.inputs, in)
+	delete(c.failed, in)
+}
+
+// switchTo replaces the client by one of serviceURL. The running inputs are
+// stopped, and subscribed and started again with the new client. Messages not
+// acknowledged yet are redelivered by the cluster switched to, as far as the
+// subscription is replicated there.
+func (c *cluster) switchTo(serviceURL string) error {
+	c.switchMu.Lock()
+	defer c.switchMu.Unlock()
+	return c.replaceClient(serviceURL)
+}
+
+// reconnect replaces the client by a new one of the active service URL, e.g.
+// to use rotated credentials. Closing the consumers drops non durable
+// subscriptions, whose consumers start at their initial position again.
+func (c *cluster) reconnect() error {
+	c.switchMu.Lock()
+	defer c.switchMu.Unlock()
+	return c.replaceClient(c.activeURL())
+}
+
+func (c *cluster) replaceClient(serviceURL string) error {
+	client, err := c.connect(serviceURL)
+	if err != nil {
+		return err
+	}
+	// the admin client is created again as well, as it holds the credentials
+	admin, err := c.connectAdmin()
+	if err != nil {
+		(*client).Close()
+		return fmt.Errorf("error creating pulsar admin client: %v", err)
+	}
+
+	c.inputsMu.Lock()
+	defer c.inputsMu.Unlock()
+
+	for in := range c.inputs {
+		in.stop()
+	}
+
+	c.mu.Lock()
+	previous := c.client
+	c.client = client
+	c.admin = admin
+	c.serviceURL = serviceURL
+	c.mu.Unlock()
+
+	for in := range c.inputs {
+		c.reopen(in, in.topics)
+	}
+
+	(*previous).Close()
+	return nil
+}
+
+// reopen subscribes the stopped input to topics and starts it. If that fails,
+// it is retried by retryFailed. The caller holds the lock of the inputs.
+func (c *cluster) reopen(in *input, topics []string) {
+	if err := in.reopen(topics); err != nil {
+		logp.Err("Restarting %s at %s failed, retrying later: %v", in, c.activeURL(), err)
+		if c.failed == nil {
+			c.failed = make(map[*input]struct{})
+		}
+		c.failed[in] = struct{}{}
+		return
+	}
+	delete(c.failed, in)
+}
+
+// retryFailed restarts the inputs which failed to restart before. It is called
+// periodically by the goroutines replacing the client or the topics.
+func (c *cluster) retryFailed() {
+	c.switchMu.Lock()
+	defer c.switchMu.Unlock()
+	c.inputsMu.Lock()
+	defer c.inputsMu.Unlock()
+
+	for in := range c.failed {
+		c.reopen(in, in.topics)
+	}
+}
@@ -0,0 +1,71 @@
+package beater
+
+import (
+	"fmt"
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/yukshimizu/pulsarbeat/config"
+)
+
+// codec decodes the payload of a message into the fields of one or more events.
+// The pulsar metadata is added to the events afterwards, and a zero timestamp
+// is replaced by the time the message was processed.
+type codec interface {
+	decode(msg pulsar.Message, payload []byte) ([]beat.Event, error)
+}
+
+type codecFactory func(cfg *common.Config) (codec, error)
+
+var codecs = map[string]codecFactory{
+	config.CodecPlain:       newPlainCodec,
+	config.CodecCloudEvents: newCloudEventsCodec,
+	config.CodecOTLP:        newOTLPCodec,
+	config.CodecPrometheus:  newPrometheusCodec,
+}
+
+// newCodec creates the codec selected by the type setting of cfg. The plain
+// codec is used if no codec is configured.
+func newCodec(cfg *common.Config) (codec, error) {
+	if cfg == nil {
+		return plainCodec{}, nil
+	}
+
+	settings := struct {
+		Type string `config:"type"`
+	}{Type: config.CodecPlain}
+	if err := cfg.Unpack(&settings); err != nil {
+		return nil, err
+	}
+
+	factory, ok := codecs[settings.Type]
+	if !ok {
+		return nil, fmt.Errorf("unknown codec type: %s", settings.Type)
+	}
+	return factory(cfg)
+}
+
+// emitsSeveralEvents reports whether c may decode a message into more than one
+// event, as the otlp and prometheus codecs do.
+func emitsSeveralEvents(c codec) bool {
+	switch c.(type) {
+	case *otlpCodec, *prometheusCodec:
+		return true
+	}
+	return false
+}
+
+// plainCodec forwards the payload as the message of a single event.
+type plainCodec struct{}
+
+func newPlainCodec(_ *common.Config) (codec, error) {
+	return plainCodec{}, nil
+}
+
+func (plainCodec) decode(_ pulsar.Message, payload []byte) ([]beat.Event, error) {
+	return []beat.Event{{
+		Fields: common.MapStr{
+			"message": string(payload),
+		},
+	}}, nil
+}
@@ -0,0 +1,99 @@
+package beater
+
+import (
+	"context"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/monitoring"
+	"os"
+	"time"
+)
+
+const defaultCredentialsReloadInterval = time.Minute
+
+var credentialReloads = monitoring.NewInt(metricsRegistry, "credential_reloads")
+
+// credentialsWatcher polls the files credentials of a cluster are read from.
+// When one of them is rotated, the client is re-created with the new
+// credentials and the running inputs are resubscribed.
+type credentialsWatcher struct {
+	cluster  *cluster
+	files    []string
+	interval time.Duration
+	modified map[string]fileVersion
+}
+
+type fileVersion struct {
+	modTime time.Time
+	size    int64
+}
+
+// newCredentialsWatcher returns nil if there are no files to watch or
+// watching is disabled by a negative interval.
+func newCredentialsWatcher(c *cluster, files []string, interval time.Duration) *credentialsWatcher {
+	if len(files) == 0 || interval < 0 {
+		return nil
+	}
+	if interval == 0 {
+		interval = defaultCredentialsReloadInterval
+	}
+	w := &credentialsWatcher{
+		cluster:  c,
+		files:    files,
+		interval: interval,
+		modified: make(map[string]fileVersion, len(files)),
+	}
+	w.changed()
+	return w
+}
+
+// run polls the files until ctx is cancelled.
+func (w *credentialsWatcher) run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		w.cluster.retryFailed()
+		changed := w.changed()
+		if len(changed) == 0 {
+			continue
+		}
+		logp.Info("Credentials %v of cluster %s changed, reconnecting", changed, w.cluster.name)
+		if err := w.cluster.reconnect(); err != nil {
+			logp.Err("Reconnecting cluster %s with the changed credentials failed: %v", w.cluster.name, err)
+			// the zero version makes the next poll retry
+			for _, file := range changed {
+				w.modified[file] = fileVersion{}
+			}
+			continue
+		}
+		credentialReloads.Inc()
+	}
+}
+
+// changed records the versions of the files and returns the ones which
+// changed since the last call. Files which can not be read are skipped, as
+// they may be in the middle of being replaced.
+func (w *credentialsWatcher) changed() []string {
+	var changed []string
+	for _, file := range w.files {
+		info, err := os.Stat(file)
+		if err != nil {
+			logp.Debug(selector, "checking credentials file %s failed: %v", file, err)
+			continue
+		}
+		version := fileVersion{modTime: info.ModTime(), size: info.Size()}
+		if previous, ok := w.modified[file]; !ok || previous != version {
+			w.modified[file] = version
+			if ok {
+				changed = append(changed, file)
+			}
+		}
+	}
+	return changed
+}
@@ -0,0 +1,151 @@
+// +build !integration
+
+package beater
+
+import (
+	"context"
+	"errors"
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/apache/pulsar-client-go/pulsaradmin"
+	"github.com/yukshimizu/pulsarbeat/config"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testPulsarClient records whether it was closed and subscribes test
+// consumers, unless subscribing fails. Other methods of pulsar.Client are not
+// implemented.
+type testPulsarClient struct {
+	pulsar.Client
+	closed        int32
+	subscribeFail int32
+}
+
+func (c *testPulsarClient) Subscribe(pulsar.ConsumerOptions) (pulsar.Consumer, error) {
+	if atomic.LoadInt32(&c.subscribeFail) != 0 {
+		return nil, errors.New("subscribing failed")
+	}
+	return newTestConsumer(), nil
+}
+
+func (c *testPulsarClient) Close() {
+	atomic.StoreInt32(&c.closed, 1)
+}
+
+func TestNewClustersWatchesCredentials(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pulsarbeat")
+	if err != nil {
+		t.Fatalf("Could not create directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	caFile := filepath.Join(dir, "ca.cert.pem")
+	if err := ioutil.WriteFile(caFile, []byte("ca"), 0600); err != nil {
+		t.Fatalf("Could not write file: %v", err)
+	}
+
+	c := config.DefaultConfig
+	c.Client.URL = "pulsar+ssl://localhost:6651"
+	c.Client.TLSTrustCertsFilePath = caFile
+	c.Client.CredentialsReloadInterval = time.Second
+	clusters, err := newClusters(c)
+	if err != nil {
+		t.Fatalf("Could not create clusters: %v", err)
+	}
+	defer closeClusters(clusters)
+
+	w := clusters[""].credentials
+	if w == nil {
+		t.Fatal("Expected the credentials files to be watched")
+	}
+	if !reflect.DeepEqual(w.files, []string{caFile}) || w.interval != time.Second {
+		t.Errorf("Expected %s to be watched every second, got %v every %v", caFile, w.files, w.interval)
+	}
+
+	c.Client.CredentialsReloadInterval = -1
+	disabled, err := newClusters(c)
+	if err != nil {
+		t.Fatalf("Could not create clusters: %v", err)
+	}
+	defer closeClusters(disabled)
+	if disabled[""].credentials != nil {
+		t.Error("Expected watching to be disabled by a negative interval")
+	}
+}
+
+func TestCredentialsWatcherReconnects(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pulsarbeat")
+	if err != nil {
+		t.Fatalf("Could not create directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	tokenFile := filepath.Join(dir, "token")
+	if err := ioutil.WriteFile(tokenFile, []byte("token"), 0600); err != nil {
+		t.Fatalf("Could not write file: %v", err)
+	}
+
+	var clients []*testPulsarClient
+	var connects int32
+	newClient := func() *pulsar.Client {
+		tc := &testPulsarClient{}
+		clients = append(clients, tc)
+		var client pulsar.Client = tc
+		return &client
+	}
+	cl := &cluster{
+		name:       "test",
+		client:     newClient(),
+		serviceURL: "pulsar://localhost:6650",
+		inputs:     make(map[*input]struct{}),
+	}
+	cl.connect = func(serviceURL string) (*pulsar.Client, error) {
+		atomic.AddInt32(&connects, 1)
+		return newClient(), nil
+	}
+	var adminConnects int32
+	cl.connectAdmin = func() (pulsaradmin.Client, error) {
+		atomic.AddInt32(&adminConnects, 1)
+		return nil, nil
+	}
+
+	w := newCredentialsWatcher(cl, []string{tokenFile}, 10*time.Millisecond)
+	reloads := credentialReloads.Get()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		w.run(ctx)
+	}()
+
+	// unchanged files do not reconnect
+	time.Sleep(50 * time.Millisecond)
+	if n := atomic.LoadInt32(&connects); n != 0 {
+		t.Fatalf("Expected no reconnect before the rotation, got %d", n)
+	}
+
+	if err := ioutil.WriteFile(tokenFile, []byte("rotated token"), 0600); err != nil {
+		t.Fatalf("Could not rotate file: %v", err)
+	}
+	waitFor(t, "the client to reconnect", func() bool {
+		return atomic.LoadInt32(&connects) == 1
+	})
+	cancel()
+	<-done
+
+	if atomic.LoadInt32(&clients[0].closed) != 1 {
+		t.Error("Expected the previous client to be closed")
+	}
+	if *cl.current() != pulsar.Client(clients[1]) {
+		t.Error("Expected the cluster to use the new client")
+	}
+	if n := atomic.LoadInt32(&adminConnects); n != 1 {
+		t.Errorf("Expected the admin client to be created again with the credentials, got %d", n)
+	}
+	if n := credentialReloads.Get() - reloads; n != 1 {
+		t.Errorf("Expected 1 credential reload, got %d", n)
+	}
+}
@@ -0,0 +1,123 @@
+package beater
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+const (
+	encodingGzip   = "gzip"
+	encodingZstd   = "zstd"
+	encodingSnappy = "snappy"
+	encodingLz4    = "lz4"
+)
+
+var (
+	magicGzip         = []byte{0x1f, 0x8b}
+	magicZstd         = []byte{0x28, 0xb5, 0x2f, 0xfd}
+	magicLz4          = []byte{0x04, 0x22, 0x4d, 0x18}
+	magicSnappyFramed = []byte("\xff\x06\x00\x00sNaPpY")
+)
+
+// decompressor restores payloads compressed by the producing application. The
+// encoding is taken from a message property or detected from the leading
+// magic bytes of the payload. Decompressed payloads larger than maxSize are
+// rejected to guard against decompression bombs.
+type decompressor struct {
+	property string
+	detect   bool
+	maxSize  int64
+}
+
+// decompress returns the decompressed payload of msg and its encoding, or the
+// payload as is with an empty encoding if it is not compressed.
+func (d *decompressor) decompress(msg pulsar.Message) ([]byte, string, error) {
+	payload := msg.Payload()
+
+	encoding := strings.ToLower(strings.TrimSpace(msg.Properties()[d.property]))
+	switch encoding {
+	case "", "identity":
+		if !d.detect {
+			return payload, "", nil
+		}
+		encoding = detectEncoding(payload)
+		if encoding == "" {
+			return payload, "", nil
+		}
+	case "x-gzip":
+		encoding = encodingGzip
+	case "x-snappy-framed":
+		encoding = encodingSnappy
+	}
+
+	var r io.Reader
+	switch encoding {
+	case encodingGzip:
+		zr, err := gzip.NewReader(bytes.NewReader(payload))
+		if err != nil {
+			return nil, encoding, err
+		}
+		defer zr.Close()
+		r = zr
+	case encodingZstd:
+		// a single goroutine per message, the default starts GOMAXPROCS
+		zr, err := zstd.NewReader(bytes.NewReader(payload), zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, encoding, err
+		}
+		defer zr.Close()
+		r = zr
+	case encodingSnappy:
+		if !bytes.HasPrefix(payload, magicSnappyFramed) {
+			return d.decodeSnappyBlock(payload)
+		}
+		r = snappy.NewReader(bytes.NewReader(payload))
+	case encodingLz4:
+		r = lz4.NewReader(bytes.NewReader(payload))
+	default:
+		return nil, encoding, fmt.Errorf("unsupported content encoding: %s", encoding)
+	}
+
+	decompressed, err := ioutil.ReadAll(io.LimitReader(r, d.maxSize+1))
+	if err != nil {
+		return nil, encoding, err
+	}
+	if int64(len(decompressed)) > d.maxSize {
+		return nil, encoding, fmt.Errorf("decompressed payload exceeds %d bytes", d.maxSize)
+	}
+	return decompressed, encoding, nil
+}
+
+func (d *decompressor) decodeSnappyBlock(payload []byte) ([]byte, string, error) {
+	n, err := snappy.DecodedLen(payload)
+	if err != nil {
+		return nil, encodingSnappy, err
+	}
+	if int64(n) > d.maxSize {
+		return nil, encodingSnappy, fmt.Errorf("decompressed payload exceeds %d bytes", d.maxSize)
+	}
+	decompressed, err := snappy.Decode(nil, payload)
+	return decompressed, encodingSnappy, err
+}
+
+func detectEncoding(payload []byte) string {
+	switch {
+	case bytes.HasPrefix(payload, magicGzip):
+		return encodingGzip
+	case bytes.HasPrefix(payload, magicZstd):
+		return encodingZstd
+	case bytes.HasPrefix(payload, magicLz4):
+		return encodingLz4
+	case bytes.HasPrefix(payload, magicSnappyFramed):
+		return encodingSnappy
+	}
+	return ""
+}
@@ -0,0 +1,218 @@
+// +build !integration
+
+package beater
+
+import (
+	"bytes"
+	"compress/gzip"
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4"
+	"strings"
+	"testing"
+)
+
+func compress(t *testing.T, encoding string, data []byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	switch encoding {
+	case encodingGzip:
+		w := gzip.NewWriter(&buf)
+		w.Write(data)
+		w.Close()
+	case encodingZstd:
+		w, err := zstd.NewWriter(&buf)
+		if err != nil {
+			t.Fatalf("Could not create zstd writer: %v", err)
+		}
+		w.Write(data)
+		w.Close()
+	case encodingLz4:
+		w := lz4.NewWriter(&buf)
+		w.Write(data)
+		w.Close()
+	case encodingSnappy:
+		w := snappy.NewBufferedWriter(&buf)
+		w.Write(data)
+		w.Close()
+	case "snappy-block":
+		return snappy.Encode(nil, data)
+	default:
+		t.Fatalf("Unknown encoding: %s", encoding)
+	}
+	return buf.Bytes()
+}
+
+func TestDecompress(t *testing.T) {
+	data := []byte(strings.Repeat("pulsarbeat ", 100))
+
+	tests := []struct {
+		name         string
+		property     string
+		payload      []byte
+		detect       bool
+		maxSize      int64
+		wantEncoding string
+		wantPayload  []byte
+		wantErr      bool
+	}{
+		{
+			name:        "uncompressed",
+			payload:     data,
+			detect:      true,
+			wantPayload: data,
+		},
+		{
+			name:        "identity",
+			property:    "identity",
+			payload:     data,
+			wantPayload: data,
+		},
+		{
+			name:         "gzip property",
+			property:     "gzip",
+			payload:      compress(t, encodingGzip, data),
+			wantEncoding: encodingGzip,
+			wantPayload:  data,
+		},
+		{
+			name:         "x-gzip property",
+			property:     " X-Gzip ",
+			payload:      compress(t, encodingGzip, data),
+			wantEncoding: encodingGzip,
+			wantPayload:  data,
+		},
+		{
+			name:         "zstd property",
+			property:     "zstd",
+			payload:      compress(t, encodingZstd, data),
+			wantEncoding: encodingZstd,
+			wantPayload:  data,
+		},
+		{
+			name:         "lz4 property",
+			property:     "lz4",
+			payload:      compress(t, encodingLz4, data),
+			wantEncoding: encodingLz4,
+			wantPayload:  data,
+		},
+		{
+			name:         "snappy framed property",
+			property:     "x-snappy-framed",
+			payload:      compress(t, encodingSnappy, data),
+			wantEncoding: encodingSnappy,
+			wantPayload:  data,
+		},
+		{
+			name:         "snappy block property",
+			property:     "snappy",
+			payload:      compress(t, "snappy-block", data),
+			wantEncoding: encodingSnappy,
+			wantPayload:  data,
+		},
+		{
+			name:         "gzip magic bytes",
+			payload:      compress(t, encodingGzip, data),
+			detect:       true,
+			wantEncoding: encodingGzip,
+			wantPayload:  data,
+		},
+		{
+			name:         "zstd magic bytes",
+			payload:      compress(t, encodingZstd, data),
+			detect:       true,
+			wantEncoding: encodingZstd,
+			wantPayload:  data,
+		},
+		{
+			name:         "lz4 magic bytes",
+			payload:      compress(t, encodingLz4, data),
+			detect:       true,
+			wantEncoding: encodingLz4,
+			wantPayload:  data,
+		},
+		{
+			name:         "snappy framed magic bytes",
+			payload:      compress(t, encodingSnappy, data),
+			detect:       true,
+			wantEncoding: encodingSnappy,
+			wantPayload:  data,
+		},
+		{
+			name:        "magic bytes without detection",
+			payload:     compress(t, encodingGzip, data),
+			wantPayload: compress(t, encodingGzip, data),
+		},
+		{
+			name:         "gzip beyond max size",
+			property:     "gzip",
+			payload:      compress(t, encodingGzip, data),
+			maxSize:      int64(len(data)) - 1,
+			wantEncoding: encodingGzip,
+			wantErr:      true,
+		},
+		{
+			name:         "gzip at max size",
+			property:     "gzip",
+			payload:      compress(t, encodingGzip, data),
+			maxSize:      int64(len(data)),
+			wantEncoding: encodingGzip,
+			wantPayload:  data,
+		},
+		{
+			name:         "zstd beyond max size",
+			payload:      compress(t, encodingZstd, data),
+			detect:       true,
+			maxSize:      100,
+			wantEncoding: encodingZstd,
+			wantErr:      true,
+		},
+		{
+			name:         "snappy block beyond max size",
+			property:     "snappy",
+			payload:      compress(t, "snappy-block", data),
+			maxSize:      100,
+			wantEncoding: encodingSnappy,
+			wantErr:      true,
+		},
+		{
+			name:         "corrupt gzip",
+			property:     "gzip",
+			payload:      []byte("not gzip"),
+			wantEncoding: encodingGzip,
+			wantErr:      true,
+		},
+		{
+			name:         "unsupported encoding",
+			property:     "br",
+			payload:      data,
+			wantEncoding: "br",
+			wantErr:      true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			maxSize := test.maxSize
+			if maxSize == 0 {
+				maxSize = 1 << 20
+			}
+			d := &decompressor{property: "content-encoding", detect: test.detect, maxSize: maxSize}
+			msg := &testMessage{
+				payload:    test.payload,
+				properties: map[string]string{"content-encoding": test.property},
+			}
+
+			payload, encoding, err := d.decompress(msg)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if encoding != test.wantEncoding {
+				t.Errorf("Expected encoding %q, got %q", test.wantEncoding, encoding)
+			}
+			if !test.wantErr && !bytes.Equal(payload, test.wantPayload) {
+				t.Errorf("Expected payload %q, got %q", test.wantPayload, payload)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package beater
+
+import (
+	"context"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/yukshimizu/pulsarbeat/config"
+	"strings"
+	"time"
+)
+
+// defaultAutoDiscoveryPeriod is the period of the pulsar client as well.
+const defaultAutoDiscoveryPeriod = time.Minute
+
+// discoverTopics looks up the topics matching the topics pattern every auto
+// discovery period until ctx is cancelled, and resubscribes the input when
+// they changed. Resubscribing closes the consumers of all topics, not only of
+// the removed ones, so unacknowledged messages are redelivered on every topic.
+func (in *input) discoverTopics(ctx context.Context) {
+	period := in.config.Consumer.AutoDiscoveryPeriod
+	if period <= 0 {
+		period = defaultAutoDiscoveryPeriod
+	}
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		topics, err := config.DiscoverTopics(in.cluster.currentAdmin(), in.config.Consumer)
+		if err != nil {
+			logp.Err("Discovering topics of %s failed: %v", in, err)
+			continue
+		}
+		if len(topics) == 0 {
+			logp.Warn("No topics match %s, keeping the subscribed topics", in.config.Consumer.TopicsPattern)
+			continue
+		}
+
+		in.cluster.retryFailed()
+		in.cluster.inputsMu.Lock()
+		if !equalTopics(topics, in.topics) {
+			logp.Info("Topics of %s changed to %s", in, strings.Join(topics, ","))
+			in.stop()
+			in.cluster.reopen(in, topics)
+		}
+		in.cluster.inputsMu.Unlock()
+	}
+}
+
+// equalTopics compares sorted topics.
+func equalTopics(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,85 @@
+package beater
+
+import (
+	"context"
+	"github.com/apache/pulsar-client-go/pulsar"
+	"hash/fnv"
+	"sync"
+	"sync/atomic"
+)
+
+const laneQueueSize = 64
+
+// dispatcher runs the processing of received messages on a fixed number of
+// parallel lanes. Tasks of the same lane run one after the other in dispatch
+// order. With per key ordering, all tasks of a key are dispatched to the same
+// lane, so messages of a key are published and acknowledged strictly in order
+// while different keys proceed in parallel. The order is the order in which
+// the messages are received: a message redelivered by the broker, e.g. after a
+// negative acknowledgement or a restart, is published again after the messages
+// of its key received before it. Tasks are not retried, as processing a
+// message does not fail: payloads which can not be decoded are published as
+// they are.
+type dispatcher struct {
+	perKey bool
+	lanes  []chan func()
+	next   uint32
+	wg     sync.WaitGroup
+}
+
+func newDispatcher(numLanes int, perKey bool) *dispatcher {
+	d := &dispatcher{
+		perKey: perKey,
+		lanes:  make([]chan func(), numLanes),
+	}
+	for i := range d.lanes {
+		lane := make(chan func(), laneQueueSize)
+		d.lanes[i] = lane
+		d.wg.Add(1)
+		go func() {
+			defer d.wg.Done()
+			for task := range lane {
+				task()
+			}
+		}()
+	}
+	return d
+}
+
+// laneOf selects the lane of key. Messages without a key and all messages when
+// ordering is not required are distributed round-robin.
+func (d *dispatcher) laneOf(key string) int {
+	if !d.perKey || key == "" {
+		return int(atomic.AddUint32(&d.next, 1) % uint32(len(d.lanes)))
+	}
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return int(h.Sum32() % uint32(len(d.lanes)))
+}
+
+// dispatch queues task on the lane of key, blocking while the lane is full.
+func (d *dispatcher) dispatch(ctx context.Context, key string, task func()) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case d.lanes[d.laneOf(key)] <- task:
+		return nil
+	}
+}
+
+// close stops accepting tasks and waits for the queued ones to finish.
+func (d *dispatcher) close() {
+	for _, lane := range d.lanes {
+		close(lane)
+	}
+	d.wg.Wait()
+}
+
+// orderingKey returns the key messages are ordered by, which is the ordering
+// key if the producer set one and the message key otherwise.
+func orderingKey(msg pulsar.Message) string {
+	if key := msg.OrderingKey(); key != "" {
+		return key
+	}
+	return msg.Key()
+}
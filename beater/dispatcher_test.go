@@ -0,0 +1,139 @@
+// +build !integration
+
+package beater
+
+import (
+	"context"
+	"math/rand"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestDispatcherPerKeyOrdering(t *testing.T) {
+	const (
+		numLanes     = 4
+		numConsumers = 4
+		numKeys      = 16
+		numMessages  = 50
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	d := newDispatcher(numLanes, true)
+
+	var mu sync.Mutex
+	processed := make(map[string][]int)
+
+	// Every consumer owns a set of keys and dispatches their messages
+	// concurrently with the other consumers. Processing takes a random time,
+	// so that lanes proceed at different speeds.
+	var wg sync.WaitGroup
+	for c := 0; c < numConsumers; c++ {
+		wg.Add(1)
+		go func(c int) {
+			defer wg.Done()
+			rnd := rand.New(rand.NewSource(int64(c)))
+			for seq := 0; seq < numMessages; seq++ {
+				for k := c; k < numKeys; k += numConsumers {
+					key := "key-" + strconv.Itoa(k)
+					seq := seq
+					delay := time.Duration(rnd.Intn(100)) * time.Microsecond
+					err := d.dispatch(ctx, key, func() {
+						time.Sleep(delay)
+						mu.Lock()
+						defer mu.Unlock()
+						processed[key] = append(processed[key], seq)
+					})
+					if err != nil {
+						t.Errorf("Could not dispatch message %s/%d: %v", key, seq, err)
+					}
+				}
+			}
+		}(c)
+	}
+	wg.Wait()
+	d.close()
+
+	if len(processed) != numKeys {
+		t.Fatalf("Expected %d keys to be processed, got %d", numKeys, len(processed))
+	}
+	for key, seqs := range processed {
+		if len(seqs) != numMessages {
+			t.Errorf("Expected %d messages of %s, got %d", numMessages, key, len(seqs))
+			continue
+		}
+		for i, seq := range seqs {
+			if seq != i {
+				t.Errorf("Messages of %s processed out of order: %v", key, seqs)
+				break
+			}
+		}
+	}
+}
+
+func TestDispatcherKeysProceedInParallel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	d := newDispatcher(2, true)
+
+	blockedKey := "blocked"
+	otherKey := ""
+	for i := 0; otherKey == ""; i++ {
+		if key := "key-" + strconv.Itoa(i); d.laneOf(key) != d.laneOf(blockedKey) {
+			otherKey = key
+		}
+	}
+
+	unblock := make(chan struct{})
+	if err := d.dispatch(ctx, blockedKey, func() {
+		<-unblock
+	}); err != nil {
+		t.Fatalf("Could not dispatch message: %v", err)
+	}
+
+	done := make(chan struct{})
+	if err := d.dispatch(ctx, otherKey, func() {
+		close(done)
+	}); err != nil {
+		t.Fatalf("Could not dispatch message: %v", err)
+	}
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Error("Message of another key is blocked by a pending key")
+	}
+	close(unblock)
+	d.close()
+}
+
+func TestDispatchCancelledWhileLaneIsFull(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	d := newDispatcher(1, true)
+
+	unblock := make(chan struct{})
+	for i := 0; i <= laneQueueSize; i++ {
+		if err := d.dispatch(ctx, "key", func() { <-unblock }); err != nil {
+			t.Fatalf("Could not dispatch message %d: %v", i, err)
+		}
+	}
+
+	dispatched := make(chan error)
+	go func() {
+		dispatched <- d.dispatch(ctx, "key", func() {})
+	}()
+	cancel()
+	select {
+	case err := <-dispatched:
+		if err != context.Canceled {
+			t.Errorf("Expected dispatch to be cancelled, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Error("Dispatch blocks after cancel")
+	}
+
+	close(unblock)
+	d.close()
+}
@@ -0,0 +1,172 @@
+package beater
+
+import (
+	"context"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/monitoring"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	defaultProbeInterval   = 30 * time.Second
+	defaultSwitchBackDelay = 5 * time.Minute
+	defaultProbeTimeout    = 10 * time.Second
+)
+
+var (
+	failoverRegistry = metricsRegistry.NewRegistry("failover")
+	failoverSwitches = monitoring.NewInt(failoverRegistry, "switches")
+)
+
+// defaultPorts are the ports of service URLs without one.
+var defaultPorts = map[string]string{
+	"pulsar":     "6650",
+	"pulsar+ssl": "6651",
+	"http":       "80",
+	"https":      "443",
+}
+
+// failover probes the service URLs of a cluster. When the active one is not
+// reachable, it switches to the first reachable one, preferring the primary.
+// Once the primary has been reachable for the switch back delay again, it
+// switches back to it.
+type failover struct {
+	cluster         *cluster
+	primary         string
+	secondaries     []string
+	probeInterval   time.Duration
+	switchBackDelay time.Duration
+	probeTimeout    time.Duration
+	active          *monitoring.String
+}
+
+func newFailover(c *cluster, primary string, secondaries []string,
+	probeInterval, switchBackDelay, probeTimeout time.Duration) *failover {
+	if probeInterval <= 0 {
+		probeInterval = defaultProbeInterval
+	}
+	if switchBackDelay <= 0 {
+		switchBackDelay = defaultSwitchBackDelay
+	}
+	if probeTimeout <= 0 {
+		probeTimeout = defaultProbeTimeout
+	}
+
+	name := c.name
+	if name == "" {
+		name = "default"
+	}
+	f := &failover{
+		cluster:         c,
+		primary:         primary,
+		secondaries:     secondaries,
+		probeInterval:   probeInterval,
+		switchBackDelay: switchBackDelay,
+		probeTimeout:    probeTimeout,
+		active:          serviceURLVar(name),
+	}
+	f.active.Set(primary)
+	return f
+}
+
+// serviceURLVar returns the metric of the active service URL of the cluster
+// name, which exists already if the clusters were created before.
+func serviceURLVar(name string) *monitoring.String {
+	if v, ok := failoverRegistry.Get(name + "_service_url").(*monitoring.String); ok {
+		return v
+	}
+	return monitoring.NewString(failoverRegistry, name+"_service_url")
+}
+
+// run probes the service URLs until ctx is cancelled.
+func (f *failover) run(ctx context.Context) {
+	ticker := time.NewTicker(f.probeInterval)
+	defer ticker.Stop()
+
+	var primaryUpSince time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		f.cluster.retryFailed()
+		active := f.cluster.activeURL()
+		if active == f.primary {
+			if !f.reachable(active) {
+				f.switchAway(active)
+			}
+			continue
+		}
+
+		if !f.reachable(f.primary) {
+			primaryUpSince = time.Time{}
+			if !f.reachable(active) {
+				f.switchAway(active)
+			}
+			continue
+		}
+		if primaryUpSince.IsZero() {
+			primaryUpSince = time.Now()
+			logp.Info("Primary service URL %s of cluster %s is reachable again", f.primary, f.cluster.name)
+		}
+		if time.Since(primaryUpSince) >= f.switchBackDelay {
+			f.switchTo(f.primary)
+			primaryUpSince = time.Time{}
+		}
+	}
+}
+
+// switchAway switches from the unreachable active URL to the first reachable
+// other one.
+func (f *failover) switchAway(active string) {
+	logp.Warn("Service URL %s of cluster %s is not reachable", active, f.cluster.name)
+	for _, serviceURL := range append([]string{f.primary}, f.secondaries...) {
+		if serviceURL != active && f.reachable(serviceURL) {
+			f.switchTo(serviceURL)
+			return
+		}
+	}
+	logp.Warn("No service URL of cluster %s is reachable, staying with %s", f.cluster.name, active)
+}
+
+func (f *failover) switchTo(serviceURL string) {
+	from := f.cluster.activeURL()
+	if err := f.cluster.switchTo(serviceURL); err != nil {
+		logp.Err("Switching cluster %s from %s to %s failed: %v", f.cluster.name, from, serviceURL, err)
+		return
+	}
+	failoverSwitches.Inc()
+	f.active.Set(serviceURL)
+	logp.Info("Switched cluster %s from %s to %s", f.cluster.name, from, serviceURL)
+}
+
+// reachable reports whether a TCP connection can be opened to any host of
+// serviceURL, e.g. pulsar://host1:6650,host2:6650.
+func (f *failover) reachable(serviceURL string) bool {
+	scheme := "pulsar"
+	hosts := serviceURL
+	if i := strings.Index(serviceURL, "://"); i >= 0 {
+		scheme, hosts = serviceURL[:i], serviceURL[i+3:]
+	}
+	if i := strings.IndexAny(hosts, "/?"); i >= 0 {
+		hosts = hosts[:i]
+	}
+
+	for _, host := range strings.Split(hosts, ",") {
+		if _, _, err := net.SplitHostPort(host); err != nil {
+			host = net.JoinHostPort(strings.Trim(host, "[]"), defaultPorts[scheme])
+		}
+		conn, err := net.DialTimeout("tcp", host, f.probeTimeout)
+		if err == nil {
+			conn.Close()
+			return true
+		}
+		logp.Debug(selector, "probing %s failed: %v", (&url.URL{Scheme: scheme, Host: host}).String(), err)
+	}
+	return false
+}
@@ -0,0 +1,184 @@
+// +build !integration
+
+package beater
+
+import (
+	"context"
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/apache/pulsar-client-go/pulsaradmin"
+	"github.com/yukshimizu/pulsarbeat/config"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// listen opens a listener on a free local port, standing in for a broker.
+func listen(t *testing.T, addr string) net.Listener {
+	t.Helper()
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		t.Fatalf("Could not listen: %v", err)
+	}
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			conn.Close()
+		}
+	}()
+	return l
+}
+
+// unreachable returns the address of a closed local port.
+func unreachable(t *testing.T) string {
+	t.Helper()
+	l := listen(t, "127.0.0.1:0")
+	addr := l.Addr().String()
+	l.Close()
+	return addr
+}
+
+// testCluster returns a cluster at serviceURL whose clients are
+// testPulsarClients.
+func testCluster(name, serviceURL string) *cluster {
+	newClient := func() *pulsar.Client {
+		var client pulsar.Client = &testPulsarClient{}
+		return &client
+	}
+	return &cluster{
+		name:       name,
+		client:     newClient(),
+		serviceURL: serviceURL,
+		inputs:     make(map[*input]struct{}),
+		connect: func(string) (*pulsar.Client, error) {
+			return newClient(), nil
+		},
+		connectAdmin: func() (pulsaradmin.Client, error) {
+			return nil, nil
+		},
+	}
+}
+
+func TestFailoverReachable(t *testing.T) {
+	up := listen(t, "127.0.0.1:0")
+	defer up.Close()
+	down := unreachable(t)
+
+	f := newFailover(testCluster("reachable", "pulsar://"+up.Addr().String()), "pulsar://"+up.Addr().String(),
+		nil, 0, 0, time.Second)
+	tests := []struct {
+		serviceURL string
+		want       bool
+	}{
+		{serviceURL: "pulsar://" + up.Addr().String(), want: true},
+		{serviceURL: "pulsar://" + down},
+		{serviceURL: "pulsar://" + down + "," + up.Addr().String(), want: true},
+		{serviceURL: "pulsar+ssl://" + up.Addr().String() + "/?param=1", want: true},
+		{serviceURL: up.Addr().String(), want: true},
+	}
+	for _, test := range tests {
+		if got := f.reachable(test.serviceURL); got != test.want {
+			t.Errorf("Expected %s to be reachable %v, got %v", test.serviceURL, test.want, got)
+		}
+	}
+}
+
+func TestFailoverSwitchesAwayAndBack(t *testing.T) {
+	primaryListener := listen(t, "127.0.0.1:0")
+	primaryAddr := primaryListener.Addr().String()
+	secondaryListener := listen(t, "127.0.0.1:0")
+	defer secondaryListener.Close()
+	primary := "pulsar://" + primaryAddr
+	secondary := "pulsar://" + secondaryListener.Addr().String()
+
+	cl := testCluster("switching", primary)
+	f := newFailover(cl, primary, []string{"pulsar://" + unreachable(t), secondary},
+		10*time.Millisecond, 100*time.Millisecond, time.Second)
+	switches := failoverSwitches.Get()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		f.run(ctx)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	primaryListener.Close()
+	waitFor(t, "the switch to the reachable secondary", func() bool {
+		return cl.activeURL() == secondary
+	})
+	if got := f.active.Get(); got != secondary {
+		t.Errorf("Expected the active service URL metric %s, got %s", secondary, got)
+	}
+
+	primaryListener = listen(t, primaryAddr)
+	defer primaryListener.Close()
+	reachableAgain := time.Now()
+	waitFor(t, "the switch back to the primary", func() bool {
+		return cl.activeURL() == primary
+	})
+	if elapsed := time.Since(reachableAgain); elapsed < 100*time.Millisecond {
+		t.Errorf("Expected the switch back after the delay, got it after %v", elapsed)
+	}
+	if n := failoverSwitches.Get() - switches; n != 2 {
+		t.Errorf("Expected 2 switches, got %d", n)
+	}
+}
+
+func TestClusterRetriesFailedInputs(t *testing.T) {
+	cl := testCluster("retrying", "pulsar://localhost:6650")
+	var next *testPulsarClient
+	cl.connect = func(string) (*pulsar.Client, error) {
+		next = &testPulsarClient{subscribeFail: 1}
+		var client pulsar.Client = next
+		return &client, nil
+	}
+
+	c := config.DefaultConfig
+	c.Consumer.Topic = "my-topic"
+	bt := &pulsarbeat{config: c, clusters: map[string]*cluster{"": cl}}
+	in, err := newInput(bt, testPipeline{client: &testClient{}}, c)
+	if err != nil {
+		t.Fatalf("Could not create input: %v", err)
+	}
+	if err := in.subscribe(); err != nil {
+		t.Fatalf("Could not subscribe: %v", err)
+	}
+	if err := in.launch(); err != nil {
+		t.Fatalf("Could not launch input: %v", err)
+	}
+	defer in.Stop()
+
+	if err := cl.switchTo("pulsar://localhost:6651"); err != nil {
+		t.Fatalf("Could not switch: %v", err)
+	}
+	if _, ok := cl.failed[in]; !ok || in.cancel != nil {
+		t.Fatal("Expected the input to be stopped and retried after failing to resubscribe")
+	}
+
+	cl.retryFailed()
+	if _, ok := cl.failed[in]; !ok {
+		t.Fatal("Expected the input to be retried again while subscribing fails")
+	}
+
+	atomic.StoreInt32(&next.subscribeFail, 0)
+	cl.retryFailed()
+	if _, ok := cl.failed[in]; ok || in.cancel == nil {
+		t.Error("Expected the input to be running again")
+	}
+}
+
+func TestNewFailoverTwice(t *testing.T) {
+	first := newFailover(testCluster("", "pulsar://a:6650"), "pulsar://a:6650", []string{"pulsar://b:6650"}, 0, 0, 0)
+	second := newFailover(testCluster("", "pulsar://c:6650"), "pulsar://c:6650", []string{"pulsar://d:6650"}, 0, 0, 0)
+	if first.active != second.active || second.active.Get() != "pulsar://c:6650" {
+		t.Errorf("Expected the clusters created again to share the metric, got %s", second.active.Get())
+	}
+}
@@ -0,0 +1,76 @@
+package beater
+
+import (
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/conditions"
+	"github.com/elastic/beats/v7/libbeat/monitoring"
+)
+
+var filteredMessages = monitoring.NewInt(metricsRegistry, "filtered_messages")
+
+// messageFilter selects messages by their metadata before they are decoded.
+// Conditions are evaluated against the fields topic, key, ordering_key,
+// producer, properties, size and payload_prefix.
+type messageFilter struct {
+	include      conditions.Condition
+	exclude      conditions.Condition
+	prefixLength int
+}
+
+// newMessageFilter returns nil if neither include nor exclude is configured.
+func newMessageFilter(include, exclude *conditions.Config, prefixLength int) (*messageFilter, error) {
+	if include == nil && exclude == nil {
+		return nil, nil
+	}
+
+	f := &messageFilter{prefixLength: prefixLength}
+	var err error
+	if include != nil {
+		if f.include, err = conditions.NewCondition(include); err != nil {
+			return nil, err
+		}
+	}
+	if exclude != nil {
+		if f.exclude, err = conditions.NewCondition(exclude); err != nil {
+			return nil, err
+		}
+	}
+	return f, nil
+}
+
+// keep reports whether msg passes the filter. A nil filter keeps everything.
+func (f *messageFilter) keep(msg pulsar.Message) bool {
+	if f == nil {
+		return true
+	}
+
+	fields := f.metadata(msg)
+	if f.include != nil && !f.include.Check(fields) {
+		return false
+	}
+	return f.exclude == nil || !f.exclude.Check(fields)
+}
+
+func (f *messageFilter) metadata(msg pulsar.Message) common.MapStr {
+	payload := msg.Payload()
+	prefix := payload
+	if len(prefix) > f.prefixLength {
+		prefix = prefix[:f.prefixLength]
+	}
+
+	properties := common.MapStr{}
+	for k, v := range msg.Properties() {
+		properties[k] = v
+	}
+
+	return common.MapStr{
+		"topic":          msg.Topic(),
+		"key":            msg.Key(),
+		"ordering_key":   msg.OrderingKey(),
+		"producer":       msg.ProducerName(),
+		"properties":     properties,
+		"size":           len(payload),
+		"payload_prefix": string(prefix),
+	}
+}
@@ -0,0 +1,114 @@
+// +build !integration
+
+package beater
+
+import (
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/conditions"
+	"testing"
+)
+
+func testCondition(t *testing.T, condition map[string]interface{}) *conditions.Config {
+	t.Helper()
+	if condition == nil {
+		return nil
+	}
+	var c conditions.Config
+	if err := common.MustNewConfigFrom(condition).Unpack(&c); err != nil {
+		t.Fatalf("Could not unpack condition: %v", err)
+	}
+	return &c
+}
+
+func TestMessageFilter(t *testing.T) {
+	msg := &testMessage{
+		topic:       "persistent://public/default/orders",
+		key:         "customer-1",
+		orderingKey: "region-1",
+		producer:    "checkout",
+		properties:  map[string]string{"type": "order", "version": "2"},
+		payload:     []byte(`{"order": 1}`),
+	}
+
+	tests := []struct {
+		name    string
+		include map[string]interface{}
+		exclude map[string]interface{}
+		prefix  int
+		keep    bool
+	}{
+		{
+			name: "no conditions",
+			keep: true,
+		},
+		{
+			name:    "include by topic",
+			include: map[string]interface{}{"equals.topic": "persistent://public/default/orders"},
+			keep:    true,
+		},
+		{
+			name:    "include not matching",
+			include: map[string]interface{}{"equals.topic": "persistent://public/default/payments"},
+			keep:    false,
+		},
+		{
+			name:    "exclude by property",
+			exclude: map[string]interface{}{"equals.properties.type": "order"},
+			keep:    false,
+		},
+		{
+			name:    "exclude not matching",
+			exclude: map[string]interface{}{"equals.properties.type": "payment"},
+			keep:    true,
+		},
+		{
+			name:    "include and exclude",
+			include: map[string]interface{}{"equals.producer": "checkout"},
+			exclude: map[string]interface{}{"equals.key": "customer-1"},
+			keep:    false,
+		},
+		{
+			name:    "ordering key",
+			include: map[string]interface{}{"equals.ordering_key": "region-1"},
+			keep:    true,
+		},
+		{
+			name:    "size",
+			include: map[string]interface{}{"range.size.lt": 10},
+			keep:    false,
+		},
+		{
+			name:    "payload prefix",
+			include: map[string]interface{}{"equals.payload_prefix": `{"order`},
+			prefix:  7,
+			keep:    true,
+		},
+		{
+			name:    "payload prefix beyond payload",
+			include: map[string]interface{}{"equals.payload_prefix": `{"order": 1}`},
+			prefix:  100,
+			keep:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f, err := newMessageFilter(testCondition(t, test.include), testCondition(t, test.exclude), test.prefix)
+			if err != nil {
+				t.Fatalf("Could not create filter: %v", err)
+			}
+			if test.include == nil && test.exclude == nil && f != nil {
+				t.Error("Expected no filter without conditions")
+			}
+			if keep := f.keep(msg); keep != test.keep {
+				t.Errorf("Expected keep %v, got %v", test.keep, keep)
+			}
+		})
+	}
+}
+
+func TestMessageFilterInvalidCondition(t *testing.T) {
+	if _, err := newMessageFilter(nil, &conditions.Config{}, 0); err == nil {
+		t.Error("Expected an error for an invalid condition")
+	}
+}
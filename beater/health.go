@@ -0,0 +1,202 @@
+package beater
+
+import (
+	"context"
+	"fmt"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/monitoring"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	healthStalled   = "stalled"
+	healthRecovered = "recovered"
+)
+
+var (
+	healthRegistry = metricsRegistry.NewRegistry("health")
+	stalledTopics  = monitoring.NewInt(healthRegistry, "stalled_topics")
+	partitionRegex = regexp.MustCompile(`-partition-\d+$`)
+)
+
+// topicActivity tracks when the last message of a topic was received.
+type topicActivity struct {
+	within      time.Duration
+	lastMessage time.Time
+	stalled     bool
+}
+
+// healthMonitor detects topics not receiving any message within their expected
+// window. It publishes a health event when a topic stalls and when it recovers.
+type healthMonitor struct {
+	mu            sync.Mutex
+	defaultWithin time.Duration
+	topics        map[string]*topicActivity
+	publish       func(beat.Event)
+}
+
+func newHealthMonitor(defaultWithin time.Duration, publish func(beat.Event)) *healthMonitor {
+	return &healthMonitor{
+		defaultWithin: defaultWithin,
+		topics:        make(map[string]*topicActivity),
+		publish:       publish,
+	}
+}
+
+// watch starts monitoring topic with the given window, counting from now.
+func (h *healthMonitor) watch(topic string, within time.Duration) {
+	if within <= 0 {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.topics[fullTopicName(topic)] = &topicActivity{within: within, lastMessage: time.Now()}
+}
+
+// enabled reports whether any topic is or can be monitored.
+func (h *healthMonitor) enabled() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.defaultWithin > 0 || len(h.topics) != 0
+}
+
+// received records a message of topic, emitting a recovery event if the topic
+// was stalled. Topics not watched so far, e.g. discovered by a topics pattern,
+// are monitored with the default window.
+func (h *healthMonitor) received(topic string, t time.Time) {
+	topic = fullTopicName(topic)
+
+	h.mu.Lock()
+	activity, ok := h.topics[topic]
+	if !ok {
+		if h.defaultWithin <= 0 {
+			h.mu.Unlock()
+			return
+		}
+		activity = &topicActivity{within: h.defaultWithin}
+		h.topics[topic] = activity
+	}
+	previous := activity.lastMessage
+	activity.lastMessage = t
+	recovered := activity.stalled
+	activity.stalled = false
+	h.mu.Unlock()
+
+	if recovered {
+		stalledTopics.Dec()
+		logp.Info("Topic %s is receiving messages again", topic)
+		h.publish(healthEvent(healthRecovered, topic, activity.within, previous, t))
+	}
+}
+
+// run checks the watched topics periodically until ctx is cancelled, which
+// happens when the input stops.
+func (h *healthMonitor) run(ctx context.Context) {
+	ticker := time.NewTicker(h.checkInterval())
+	defer ticker.Stop()
+	defer h.stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			h.check(now)
+		}
+	}
+}
+
+func (h *healthMonitor) check(now time.Time) {
+	var events []beat.Event
+
+	h.mu.Lock()
+	for topic, activity := range h.topics {
+		if activity.stalled || now.Sub(activity.lastMessage) < activity.within {
+			continue
+		}
+		activity.stalled = true
+		stalledTopics.Inc()
+		logp.Warn("No message received on topic %s within %v", topic, activity.within)
+		events = append(events, healthEvent(healthStalled, topic, activity.within, activity.lastMessage, now))
+	}
+	h.mu.Unlock()
+
+	for _, event := range events {
+		h.publish(event)
+	}
+}
+
+// stop no longer counts the stalled topics of the monitor, whose input does
+// not receive them anymore. It may be restarted with a new monitor.
+func (h *healthMonitor) stop() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, activity := range h.topics {
+		if activity.stalled {
+			activity.stalled = false
+			stalledTopics.Dec()
+		}
+	}
+}
+
+// checkInterval is a tenth of the smallest window, but at least a second.
+func (h *healthMonitor) checkInterval() time.Duration {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	smallest := h.defaultWithin
+	for _, activity := range h.topics {
+		if smallest <= 0 || activity.within < smallest {
+			smallest = activity.within
+		}
+	}
+	if interval := smallest / 10; interval > time.Second {
+		return interval
+	}
+	return time.Second
+}
+
+func healthEvent(status, topic string, within time.Duration, lastMessage, now time.Time) beat.Event {
+	var message string
+	if status == healthStalled {
+		message = fmt.Sprintf("No message received on topic %s within %v", topic, within)
+	} else {
+		message = fmt.Sprintf("Topic %s is receiving messages again after %v", topic, now.Sub(lastMessage))
+	}
+
+	return beat.Event{
+		Timestamp: now,
+		Fields: common.MapStr{
+			"event": common.MapStr{
+				"kind":    "alert",
+				"dataset": "pulsarbeat.health",
+			},
+			"pulsarbeat": common.MapStr{
+				"health": common.MapStr{
+					"status":                 status,
+					"topic":                  topic,
+					"last_message":           lastMessage,
+					"expect_messages_within": within.String(),
+				},
+			},
+			"message": message,
+		},
+	}
+}
+
+// fullTopicName returns the fully qualified name of a topic, without any
+// partition suffix, so that configured and received topic names compare equal.
+func fullTopicName(topic string) string {
+	if !strings.Contains(topic, "://") {
+		if strings.Count(topic, "/") == 0 {
+			topic = "public/default/" + topic
+		}
+		topic = "persistent://" + topic
+	}
+	return partitionRegex.ReplaceAllString(topic, "")
+}
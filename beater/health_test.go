@@ -0,0 +1,145 @@
+// +build !integration
+
+package beater
+
+import (
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"sync"
+	"testing"
+	"time"
+)
+
+type healthEvents struct {
+	mu     sync.Mutex
+	events []beat.Event
+}
+
+func (e *healthEvents) publish(event beat.Event) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.events = append(e.events, event)
+}
+
+func (e *healthEvents) statuses() []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	var statuses []string
+	for _, event := range e.events {
+		status, _ := event.Fields.GetValue("pulsarbeat.health.status")
+		topic, _ := event.Fields.GetValue("pulsarbeat.health.topic")
+		statuses = append(statuses, topic.(string)+" "+status.(string))
+	}
+	return statuses
+}
+
+func equalStatuses(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestHealthMonitorCheck(t *testing.T) {
+	stalled := stalledTopics.Get()
+	events := &healthEvents{}
+	h := newHealthMonitor(0, events.publish)
+	h.watch("my-topic", time.Minute)
+	h.watch("persistent://public/default/other-topic", time.Hour)
+	start := time.Now()
+
+	h.check(start.Add(30 * time.Second))
+	if statuses := events.statuses(); len(statuses) != 0 {
+		t.Fatalf("Expected no event within the window, got %v", statuses)
+	}
+
+	h.check(start.Add(2 * time.Minute))
+	h.check(start.Add(3 * time.Minute))
+	want := []string{"persistent://public/default/my-topic stalled"}
+	if statuses := events.statuses(); !equalStatuses(statuses, want) {
+		t.Fatalf("Expected events %v, got %v", want, statuses)
+	}
+	if n := stalledTopics.Get() - stalled; n != 1 {
+		t.Errorf("Expected 1 stalled topic, got %d", n)
+	}
+
+	h.received("persistent://public/default/my-topic-partition-2", start.Add(4*time.Minute))
+	want = append(want, "persistent://public/default/my-topic recovered")
+	if statuses := events.statuses(); !equalStatuses(statuses, want) {
+		t.Fatalf("Expected events %v, got %v", want, statuses)
+	}
+	if n := stalledTopics.Get() - stalled; n != 0 {
+		t.Errorf("Expected no stalled topic after recovery, got %d", n)
+	}
+
+	h.check(start.Add(4*time.Minute + 30*time.Second))
+	if statuses := events.statuses(); !equalStatuses(statuses, want) {
+		t.Errorf("Expected no event after recovery, got %v", statuses)
+	}
+}
+
+func TestHealthMonitorReceived(t *testing.T) {
+	events := &healthEvents{}
+	start := time.Now()
+
+	h := newHealthMonitor(0, events.publish)
+	h.received("unwatched", start)
+	h.check(start.Add(time.Hour))
+	if statuses := events.statuses(); len(statuses) != 0 {
+		t.Errorf("Expected topics without window to be ignored, got %v", statuses)
+	}
+
+	h = newHealthMonitor(time.Minute, events.publish)
+	if !h.enabled() {
+		t.Fatal("Expected monitor with default window to be enabled")
+	}
+	h.received("persistent://public/default/discovered", start)
+	h.check(start.Add(30 * time.Second))
+	h.check(start.Add(2 * time.Minute))
+	want := []string{"persistent://public/default/discovered stalled"}
+	if statuses := events.statuses(); !equalStatuses(statuses, want) {
+		t.Errorf("Expected events %v, got %v", want, statuses)
+	}
+	h.stop()
+}
+
+func TestHealthMonitorStop(t *testing.T) {
+	stalled := stalledTopics.Get()
+	events := &healthEvents{}
+	h := newHealthMonitor(0, events.publish)
+	h.watch("my-topic", time.Minute)
+	h.watch("other-topic", time.Minute)
+
+	h.check(time.Now().Add(2 * time.Minute))
+	if n := stalledTopics.Get() - stalled; n != 2 {
+		t.Fatalf("Expected 2 stalled topics, got %d", n)
+	}
+
+	h.stop()
+	if n := stalledTopics.Get() - stalled; n != 0 {
+		t.Errorf("Expected stalled topics of a stopped input not to be counted, got %d", n)
+	}
+	h.received("my-topic", time.Now())
+	if n := stalledTopics.Get() - stalled; n != 0 {
+		t.Errorf("Expected no recovery after stop, got %d", n)
+	}
+}
+
+func TestFullTopicName(t *testing.T) {
+	tests := map[string]string{
+		"my-topic":                                  "persistent://public/default/my-topic",
+		"my-tenant/my-ns/my-topic":                  "persistent://my-tenant/my-ns/my-topic",
+		"persistent://public/default/my-topic":      "persistent://public/default/my-topic",
+		"non-persistent://public/default/events":    "non-persistent://public/default/events",
+		"persistent://public/default/t-partition-3": "persistent://public/default/t",
+	}
+	for topic, want := range tests {
+		if got := fullTopicName(topic); got != want {
+			t.Errorf("Expected full name of %s to be %s, got %s", topic, want, got)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package beater
+
+import (
+	"context"
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/elastic/beats/v7/libbeat/monitoring"
+	"go.elastic.co/apm"
+	"sync"
+	"sync/atomic"
+)
+
+var inflightMessages = monitoring.NewInt(metricsRegistry, "inflight_messages")
+
+// worker receives and acknowledges the messages of a single pulsar consumer.
+// The in-flight limit is shared by the workers of an input. Messages which are
+// not acknowledged are released, and redelivered once the consumer is closed.
+type worker struct {
+	id          int
+	input       string
+	consumer    pulsar.Consumer
+	inflight    *inflightLimiter
+	rateLimiter *rateLimiter
+	lanes       *dispatcher // nil if messages are processed by the receive loop
+	received    int64
+	acked       int64
+	released    int64
+
+	mu      sync.Mutex
+	resumed chan struct{} // closed on resume, nil while not paused
+}
+
+// pendingMessage is attached to the events published for a message as private
+// data, so that the message is acknowledged once the output acknowledged all
+// of its events. The transaction, if any, ends at that point too.
+type pendingMessage struct {
+	worker      *worker
+	msg         pulsar.Message
+	events      int32
+	transaction *apm.Transaction
+}
+
+// ack acknowledges msg and frees its in-flight slot.
+func (w *worker) ack(msg pulsar.Message) {
+	w.consumer.Ack(msg)
+	atomic.AddInt64(&w.acked, 1)
+	inflightMessages.Dec()
+	w.inflight.release()
+}
+
+// release frees the in-flight slot of a message left unacknowledged, e.g. as
+// the input stopped before the message was published.
+func (w *worker) release() {
+	atomic.AddInt64(&w.released, 1)
+	inflightMessages.Dec()
+	w.inflight.release()
+}
+
+// releasePending releases the messages still waiting for the output. It is
+// called once the client of the input is closed, as the acknowledgements of
+// its events are dropped then.
+func (w *worker) releasePending() {
+	for n := w.pending(); n > 0; n-- {
+		w.release()
+	}
+}
+
+// pending returns the number of messages received but neither acknowledged
+// nor released.
+func (w *worker) pending() int64 {
+	return atomic.LoadInt64(&w.received) - atomic.LoadInt64(&w.acked) - atomic.LoadInt64(&w.released)
+}
+
+// pause stops receiving further messages until resume is called. Messages
+// already received are still published and acknowledged.
+func (w *worker) pause() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.resumed == nil {
+		w.resumed = make(chan struct{})
+	}
+}
+
+func (w *worker) resume() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.resumed != nil {
+		close(w.resumed)
+		w.resumed = nil
+	}
+}
+
+func (w *worker) paused() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.resumed != nil
+}
+
+// waitResumed blocks while the worker is paused or until ctx is cancelled.
+func (w *worker) waitResumed(ctx context.Context) error {
+	w.mu.Lock()
+	resumed := w.resumed
+	w.mu.Unlock()
+	if resumed == nil {
+		return nil
+	}
+	select {
+	case <-resumed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// ackEvents is the output ACK handler acknowledging the messages of the
+// published events.
+func ackEvents(_ int, privates []interface{}) {
+	for _, private := range privates {
+		pending, ok := private.(*pendingMessage)
+		if ok && atomic.AddInt32(&pending.events, -1) == 0 {
+			if pending.transaction != nil {
+				pending.transaction.End()
+			}
+			pending.worker.ack(pending.msg)
+		}
+	}
+}
+
+// inflightLimiter bounds the number of messages of an input which have been
+// received but not acknowledged by the output yet. A nil limiter is unbounded.
+type inflightLimiter struct {
+	slots chan struct{}
+}
+
+func newInflightLimiter(limit int) *inflightLimiter {
+	if limit <= 0 {
+		return nil
+	}
+	return &inflightLimiter{slots: make(chan struct{}, limit)}
+}
+
+// acquire blocks until a slot is free or ctx is cancelled.
+func (l *inflightLimiter) acquire(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// release frees a slot. Releasing more slots than acquired is a no-op.
+func (l *inflightLimiter) release() {
+	if l == nil {
+		return
+	}
+	select {
+	case <-l.slots:
+	default:
+	}
+}
@@ -0,0 +1,181 @@
+// +build !integration
+
+package beater
+
+import (
+	"context"
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/yukshimizu/pulsarbeat/config"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestInflightLimiter(t *testing.T) {
+	l := newInflightLimiter(2)
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		if err := l.acquire(ctx); err != nil {
+			t.Fatalf("Could not acquire slot %d: %v", i, err)
+		}
+	}
+
+	timeout, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if err := l.acquire(timeout); err != context.DeadlineExceeded {
+		t.Fatalf("Expected acquiring beyond the limit to block, got %v", err)
+	}
+
+	l.release()
+	if err := l.acquire(ctx); err != nil {
+		t.Fatalf("Could not acquire released slot: %v", err)
+	}
+
+	// releasing more slots than acquired does not block
+	for i := 0; i < 3; i++ {
+		l.release()
+	}
+	if n := len(l.slots); n != 0 {
+		t.Errorf("Expected no slot in use, got %d", n)
+	}
+
+	var unbounded *inflightLimiter
+	if newInflightLimiter(0) != nil {
+		t.Error("Expected limit 0 to be unbounded")
+	}
+	for i := 0; i < 100; i++ {
+		if err := unbounded.acquire(ctx); err != nil {
+			t.Fatalf("Could not acquire unbounded slot: %v", err)
+		}
+	}
+	unbounded.release()
+}
+
+func TestAckEvents(t *testing.T) {
+	consumer := newTestConsumer()
+	w := &worker{consumer: consumer, inflight: newInflightLimiter(1)}
+	if err := w.inflight.acquire(context.Background()); err != nil {
+		t.Fatalf("Could not acquire slot: %v", err)
+	}
+	atomic.AddInt64(&w.received, 1)
+	inflight := inflightMessages.Get()
+
+	msg := &testMessage{key: "key"}
+	pending := &pendingMessage{worker: w, msg: msg, events: 3}
+
+	ackEvents(2, []interface{}{pending, nil, pending})
+	if acked := consumer.ackedMessages(); len(acked) != 0 {
+		t.Fatalf("Expected message to wait for all its events, got %d acknowledged", len(acked))
+	}
+
+	ackEvents(1, []interface{}{pending})
+	if acked := consumer.ackedMessages(); len(acked) != 1 || acked[0] != pulsar.Message(msg) {
+		t.Fatalf("Expected message to be acknowledged once, got %v", acked)
+	}
+	if n := atomic.LoadInt64(&w.acked); n != 1 {
+		t.Errorf("Expected 1 acknowledged message, got %d", n)
+	}
+	if n := w.pending(); n != 0 {
+		t.Errorf("Expected no pending message, got %d", n)
+	}
+	if n := len(w.inflight.slots); n != 0 {
+		t.Errorf("Expected the slot to be released, got %d in use", n)
+	}
+	if n := inflightMessages.Get() - inflight; n != -1 {
+		t.Errorf("Expected in-flight messages to decrease by 1, got %d", n)
+	}
+}
+
+func TestInputLimitsInflightMessagesOfAllWorkers(t *testing.T) {
+	c := config.DefaultConfig
+	c.Consumer.Topic = "my-topic"
+	c.Consumer.MaxInflightMessages = 3
+	in, err := testInput(t, c)
+	if err != nil {
+		t.Fatalf("Could not create input: %v", err)
+	}
+	client := &testClient{hold: true}
+	in.pipeline = testPipeline{client: client}
+
+	var consumers []pulsar.Consumer
+	for i := 0; i < 2; i++ {
+		var messages []pulsar.Message
+		for j := 0; j < 5; j++ {
+			messages = append(messages, &testMessage{topic: "my-topic", payload: []byte("message")})
+		}
+		consumers = append(consumers, newTestConsumer(messages...))
+	}
+	in.consumers = &consumers
+	inflight := inflightMessages.Get()
+
+	if err := in.start(); err != nil {
+		t.Fatalf("Could not start input: %v", err)
+	}
+	waitFor(t, "the in-flight limit", func() bool {
+		return len(client.events()) == 3
+	})
+	// the workers are blocked by the limit shared among them
+	time.Sleep(50 * time.Millisecond)
+	if n := len(client.events()); n != 3 {
+		t.Errorf("Expected the workers to stop at 3 in-flight messages, got %d", n)
+	}
+	if n := inflightMessages.Get() - inflight; n != 3 {
+		t.Errorf("Expected 3 in-flight messages, got %d", n)
+	}
+
+	// acknowledgements are dropped once the client is closed
+	workers := in.workers
+	in.stop()
+	if n := len(in.inflight.slots); n != 0 {
+		t.Errorf("Expected the slots to be released on stop, got %d in use", n)
+	}
+	for _, w := range workers {
+		if n := w.pending(); n != 0 {
+			t.Errorf("Expected no pending message after stop, got %d", n)
+		}
+	}
+	if n := inflightMessages.Get() - inflight; n != 0 {
+		t.Errorf("Expected no in-flight message after stop, got %d", n)
+	}
+}
+
+func TestReceiveReleasesThrottledMessage(t *testing.T) {
+	c := config.DefaultConfig
+	c.Consumer.Topic = "my-topic"
+	c.Consumer.MaxInflightMessages = 10
+	c.Consumer.RateLimit = config.RateLimit{MessagesPerSecond: 0.1, MessagesBurst: 1}
+	in, err := testInput(t, c)
+	if err != nil {
+		t.Fatalf("Could not create input: %v", err)
+	}
+	client := &testClient{}
+	in.client = client
+
+	consumer := newTestConsumer(
+		&testMessage{topic: "my-topic", payload: []byte("first")},
+		&testMessage{topic: "my-topic", payload: []byte("throttled")},
+	)
+	w := in.newWorker(consumer)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		in.receive(ctx, w, newHealthMonitor(0, client.Publish))
+	}()
+	waitFor(t, "the second message to be throttled", func() bool {
+		return atomic.LoadInt64(&w.received) == 2
+	})
+	cancel()
+	<-done
+
+	if n := len(client.events()); n != 1 {
+		t.Errorf("Expected 1 published message, got %d", n)
+	}
+	if n := atomic.LoadInt64(&w.released); n != 1 {
+		t.Errorf("Expected the throttled message to be released, got %d", n)
+	}
+	if n := len(in.inflight.slots); n != 0 {
+		t.Errorf("Expected no slot in use, got %d", n)
+	}
+}
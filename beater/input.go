@@ -0,0 +1,522 @@
+package beater
+
+import (
+	"context"
+	"fmt"
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/cfgfile"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/acker"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/yukshimizu/pulsarbeat/config"
+	"go.elastic.co/apm"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// input consumes the topics of one consumer configuration. The consumer section
+// is an input running as long as the beat, inputs loaded from
+// pulsarbeat.config.inputs are started and stopped by the reloader.
+type input struct {
+	bt           *pulsarbeat
+	pipeline     beat.PipelineConnector
+	config       config.Config
+	cluster      *cluster
+	consumers    *[]pulsar.Consumer
+	client       beat.Client
+	filter       *messageFilter
+	sampler      *sampler
+	decompressor *decompressor
+	codec        codec
+	inflight     *inflightLimiter
+	rateLimiter  *rateLimiter
+	tracer       *apm.Tracer // nil unless the input records transactions
+	progress     *catchUp
+	cancel       context.CancelFunc
+	wg           sync.WaitGroup
+	workers      []*worker
+
+	// topics are the subscribed topics discovered through the admin API
+	topics        []string
+	stopDiscovery context.CancelFunc
+	discovery     sync.WaitGroup
+}
+
+// newInput validates the consumer configuration of c without subscribing.
+func newInput(bt *pulsarbeat, pipeline beat.PipelineConnector, c config.Config) (*input, error) {
+	filter, err := newMessageFilter(c.Consumer.Filter.Include, c.Consumer.Filter.Exclude,
+		c.Consumer.Filter.PrefixLength)
+	if err != nil {
+		return nil, fmt.Errorf("error creating message filter: %v", err)
+	}
+
+	sampler, err := newSampler(c.Consumer.Sampling.Mode, c.Consumer.Sampling.Probability,
+		c.Consumer.Sampling.ReservoirSize)
+	if err != nil {
+		return nil, fmt.Errorf("error creating sampler: %v", err)
+	}
+
+	codec, err := newCodec(c.Consumer.Codec)
+	if err != nil {
+		return nil, fmt.Errorf("error creating codec: %v", err)
+	}
+	if c.Consumer.Table.Enabled && emitsSeveralEvents(codec) {
+		// the events of a message would all update the document of its key
+		return nil, fmt.Errorf("table mode requires a codec decoding a message into a single event")
+	}
+	if c.Consumer.Table.Enabled {
+		if err := tableOutputValidate(bt.output); err != nil {
+			return nil, err
+		}
+	}
+
+	// inputs of config.inputs name the clients of the main configuration
+	cluster, ok := bt.clusters[bt.config.ResolveClient(c.Consumer.Client)]
+	if !ok {
+		return nil, fmt.Errorf("unknown client: %s", c.Consumer.Client)
+	}
+
+	in := &input{
+		bt:          bt,
+		pipeline:    pipeline,
+		config:      c,
+		cluster:     cluster,
+		filter:      filter,
+		sampler:     sampler,
+		codec:       codec,
+		inflight:    newInflightLimiter(c.Consumer.MaxInflightMessages),
+		rateLimiter: newRateLimiter(c.Consumer.RateLimit),
+	}
+	if c.Consumer.TraceContext.Transactions {
+		if in.tracer = bt.tracer(); in.tracer == nil {
+			logp.Warn("trace_context.transactions requires instrumentation to be enabled")
+		}
+	}
+	if c.Consumer.Decompression.Enabled {
+		in.decompressor = &decompressor{
+			property: c.Consumer.Decompression.Property,
+			detect:   c.Consumer.Decompression.DetectMagicBytes,
+			maxSize:  int64(c.Consumer.Decompression.MaxSize),
+		}
+	}
+	return in, nil
+}
+
+// subscribe creates the pulsar consumers of the input when it starts for the
+// first time. The topics matching a topics pattern are discovered through the
+// admin API if the pulsar client can not discover them.
+func (in *input) subscribe() error {
+	var topics []string
+	if in.config.Consumer.DiscoversTopics() {
+		var err error
+		topics, err = config.DiscoverTopics(in.cluster.currentAdmin(), in.config.Consumer)
+		if err != nil {
+			return fmt.Errorf("error discovering topics: %v", err)
+		}
+		if len(topics) == 0 {
+			return fmt.Errorf("no topics match %s", in.config.Consumer.TopicsPattern)
+		}
+	}
+	return in.createConsumers(false, topics)
+}
+
+// resubscribe creates the pulsar consumers again after the input was stopped,
+// e.g. to switch the service URL or to subscribe to changed topics. The
+// subscription exists already, so it is neither rewound nor looked up through
+// the admin API, whose cluster may be unavailable after a failover. Inputs
+// discovering topics subscribe to the given topics.
+func (in *input) resubscribe(topics []string) error {
+	return in.createConsumers(true, topics)
+}
+
+func (in *input) createConsumers(resubscribing bool, topics []string) error {
+	options := in.config.Consumer
+	admin := in.cluster.currentAdmin()
+	if resubscribing {
+		options = options.Resubscribing()
+		admin = nil
+	}
+	if options.DiscoversTopics() {
+		options.Topic, options.Topics, options.TopicsPattern = "", topics, ""
+	}
+
+	consumers, err := config.NewPulsarConsumer(in.cluster.current(), admin, options)
+	if err != nil {
+		return fmt.Errorf("error creating pulsar consumer: %v", err)
+	}
+	in.consumers = consumers
+	in.topics = topics
+	return nil
+}
+
+// reopen subscribes the stopped input to topics and starts it again.
+func (in *input) reopen(topics []string) error {
+	if err := in.resubscribe(topics); err != nil {
+		return err
+	}
+	if err := in.start(); err != nil {
+		for _, consumer := range *in.consumers {
+			consumer.Close()
+		}
+		return err
+	}
+	return nil
+}
+
+func (in *input) String() string {
+	topics := append([]string{in.config.Consumer.Topic}, in.config.Consumer.Topics...)
+	if in.config.Consumer.TopicsPattern != "" {
+		topics = append(topics, in.config.Consumer.TopicsPattern)
+	}
+	return fmt.Sprintf("pulsar input [subscription=%s, topics=%s]",
+		in.config.Consumer.SubscriptionName, strings.Join(topics, ","))
+}
+
+// Start starts receiving. Errors are logged, as the reloader retries inputs
+// failing to start on the next scan.
+func (in *input) Start() {
+	if err := in.launch(); err != nil {
+		logp.Err("Starting %s failed: %v", in, err)
+	}
+}
+
+// launch starts receiving and registers the input at its cluster, which
+// restarts it when switching the service URL.
+func (in *input) launch() error {
+	in.cluster.inputsMu.Lock()
+	defer in.cluster.inputsMu.Unlock()
+	if err := in.start(); err != nil {
+		return err
+	}
+	in.cluster.inputs[in] = struct{}{}
+
+	if in.config.Consumer.DiscoversTopics() {
+		ctx, cancel := context.WithCancel(context.Background())
+		in.stopDiscovery = cancel
+		in.discovery.Add(1)
+		go func() {
+			defer in.discovery.Done()
+			in.discoverTopics(ctx)
+		}()
+	}
+	return nil
+}
+
+func (in *input) start() error {
+	var err error
+	in.client, err = in.pipeline.ConnectWith(beat.ClientConfig{
+		// messages are acknowledged once their events are acknowledged by the output
+		ACKHandler: acker.ConnectionOnly(acker.EventPrivateReporter(ackEvents)),
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	in.cancel = cancel
+
+	health := newHealthMonitor(in.config.Consumer.Health.ExpectMessagesWithin, in.client.Publish)
+	for _, topic := range append([]string{in.config.Consumer.Topic}, in.config.Consumer.Topics...) {
+		if topic != "" {
+			health.watch(topic, in.config.Consumer.Health.ExpectMessagesWithin)
+		}
+	}
+	for _, topic := range in.config.Consumer.Health.Topics {
+		health.watch(topic.Topic, topic.ExpectMessagesWithin)
+	}
+	if health.enabled() {
+		in.wg.Add(1)
+		go func() {
+			defer in.wg.Done()
+			health.run(ctx)
+		}()
+	}
+
+	if in.sampler != nil && in.sampler.reservoir != nil {
+		in.wg.Add(1)
+		go func() {
+			defer in.wg.Done()
+			in.sampler.reservoir.run(ctx, func(m sampledMessage, sampleRate float64) {
+				in.forward(ctx, m.worker, m.msg, m.received, sampleRate)
+			})
+		}()
+	}
+
+	for _, consumer := range *in.consumers {
+		in.wg.Add(1)
+		w := in.newWorker(consumer)
+		in.bt.workers.add(w)
+		in.workers = append(in.workers, w)
+		go func() {
+			defer in.wg.Done()
+			in.receive(ctx, w, health)
+		}()
+	}
+
+	logp.Info("%s started", in)
+	return nil
+}
+
+// Stop stops receiving and waits for the messages being processed. Messages
+// whose events the output did not acknowledge yet are redelivered to the
+// subscription once the consumers are closed.
+func (in *input) Stop() {
+	if in.stopDiscovery != nil {
+		in.stopDiscovery()
+		in.discovery.Wait()
+	}
+	in.cluster.unregister(in)
+	in.stop()
+}
+
+func (in *input) stop() {
+	if in.cancel == nil {
+		return
+	}
+	in.cancel()
+	in.cancel = nil
+	in.wg.Wait()
+	// the lanes are fed by the receive loops and the reservoir
+	for _, w := range in.workers {
+		if w.lanes != nil {
+			w.lanes.close()
+		}
+		in.bt.workers.remove(w)
+		w.consumer.Close()
+		logp.Debug(selector, "pulsar consumer: %#v Closed!", w.consumer)
+	}
+	in.client.Close()
+	// the output does not acknowledge the events of the closed client anymore
+	for _, w := range in.workers {
+		w.releasePending()
+	}
+	in.workers = nil
+	logp.Info("%s stopped", in)
+}
+
+// newWorker returns the worker receiving from consumer. Its lanes are closed
+// by stop.
+func (in *input) newWorker(consumer pulsar.Consumer) *worker {
+	w := &worker{
+		input:       in.String(),
+		consumer:    consumer,
+		inflight:    in.inflight,
+		rateLimiter: in.rateLimiter,
+	}
+	if in.config.Consumer.PublishWorkers > 1 {
+		w.lanes = newDispatcher(in.config.Consumer.PublishWorkers,
+			in.config.Consumer.Ordering == config.OrderingPerKey)
+	}
+	return w
+}
+
+func (in *input) receive(ctx context.Context, w *worker, health *healthMonitor) {
+	for {
+		select {
+		case <-ctx.Done():
+			logp.Debug(selector, "done ctx")
+			return
+		default:
+			if err := w.waitResumed(ctx); err != nil {
+				continue
+			}
+			// stop receiving while too many messages wait for the output
+			if err := w.inflight.acquire(ctx); err != nil {
+				continue
+			}
+
+			// pulsar normal implementation
+			msg, err := w.consumer.Receive(ctx)
+			if err != nil {
+				w.inflight.release()
+				logp.Debug(selector, "consumer Receive failed: %v", err)
+				if err != context.Canceled {
+					w.consumer.Nack(msg)
+				}
+				continue
+			}
+			if in.progress != nil && !in.progress.count(msg) {
+				// beyond max_messages, the message is redelivered to the next run
+				w.inflight.release()
+				continue
+			}
+			inflightMessages.Inc()
+			atomic.AddInt64(&w.received, 1)
+
+			logp.Debug(selector, "Received message msgId: %#v -- content: '%s'",
+				msg.ID(), string(msg.Payload()))
+			received := time.Now()
+			health.received(msg.Topic(), received)
+
+			if !in.filter.keep(msg) {
+				logp.Debug(selector, "Filtered out message msgId: %#v", msg.ID())
+				filteredMessages.Inc()
+				w.ack(msg)
+				continue
+			}
+
+			sampleRate := 1.0
+			if in.sampler != nil {
+				if in.sampler.reservoir != nil {
+					if replaced, ok := in.sampler.reservoir.offer(sampledMessage{worker: w, msg: msg, received: received}); ok {
+						sampledOutMessages.Inc()
+						replaced.worker.ack(replaced.msg)
+					}
+					continue
+				}
+				if !in.sampler.sample(msg) {
+					sampledOutMessages.Inc()
+					w.ack(msg)
+					continue
+				}
+				sampleRate = in.sampler.probability
+			}
+			in.forward(ctx, w, msg, received, sampleRate)
+		}
+	}
+}
+
+// forward processes a message kept by the filter and the sampler once the
+// rate limit allows, on the lane of its key if the worker has lanes.
+func (in *input) forward(ctx context.Context, w *worker, msg pulsar.Message, received time.Time, sampleRate float64) {
+	if err := w.rateLimiter.wait(ctx, len(msg.Payload())); err != nil {
+		// cancelled while throttled, the message is redelivered later
+		w.release()
+		return
+	}
+
+	if w.lanes == nil {
+		in.processMessage(w, msg, received, sampleRate)
+		return
+	}
+	err := w.lanes.dispatch(ctx, orderingKey(msg), func() {
+		in.processMessage(w, msg, received, sampleRate)
+	})
+	if err != nil {
+		logp.Debug(selector, "dispatching message msgId: %#v cancelled: %v", msg.ID(), err)
+		w.release()
+	}
+}
+
+// processMessage publishes the events decoded from msg, which is acknowledged
+// once the output acknowledged all of them. Dropped messages are acknowledged
+// right away. A sampleRate below 1 is recorded on the events.
+func (in *input) processMessage(w *worker, msg pulsar.Message, received time.Time, sampleRate float64) {
+	if in.config.Consumer.Table.Enabled && msg.Key() == "" {
+		logp.Warn("Dropping message without key in table mode, msgId: %#v", msg.ID())
+		w.ack(msg)
+		return
+	}
+
+	payload := msg.Payload()
+	var encoding string
+	var payloadErr error
+	if in.decompressor != nil {
+		var decompressed []byte
+		decompressed, encoding, payloadErr = in.decompressor.decompress(msg)
+		if payloadErr == nil {
+			payload = decompressed
+		} else {
+			// the payload is forwarded as it was received
+			logp.Warn("Decompressing %s payload of msgId: %#v failed: %v", encoding, msg.ID(), payloadErr)
+			payloadErr = fmt.Errorf("decompressing %s payload: %v", encoding, payloadErr)
+		}
+	}
+
+	var events []beat.Event
+	if payloadErr == nil {
+		events, payloadErr = in.codec.decode(msg, payload)
+		if payloadErr != nil {
+			logp.Warn("Decoding payload of msgId: %#v failed: %v", msg.ID(), payloadErr)
+			payloadErr = fmt.Errorf("decoding payload: %v", payloadErr)
+		}
+	}
+	if payloadErr != nil {
+		events, _ = plainCodec{}.decode(msg, payload)
+	}
+	if len(events) == 0 {
+		logp.Debug(selector, "No event decoded from msgId: %#v", msg.ID())
+		w.ack(msg)
+		return
+	}
+
+	now := time.Now()
+	pending := &pendingMessage{worker: w, msg: msg, events: int32(len(events))}
+	if in.tracer != nil {
+		pending.transaction = startTransaction(in.tracer, msg, received)
+		if payloadErr != nil {
+			pending.transaction.Outcome = "failure"
+		}
+	}
+	for i := range events {
+		event := &events[i]
+		if event.Timestamp.IsZero() {
+			event.Timestamp = now
+		}
+		event.Private = pending
+		event.Fields.Put("pulsar.topic", msg.Topic())
+		if in.cluster.name != "" {
+			event.Fields.Put("pulsar.cluster", in.cluster.name)
+		}
+		event.Fields.Put("pulsar.producer", msg.ProducerName())
+		event.Fields.Put("pulsar.key", msg.Key())
+		event.Fields.Put("pulsar.timestamp", msg.PublishTime())
+
+		if payloadErr != nil {
+			event.Fields.Put("error.message", payloadErr.Error())
+		} else if encoding != "" {
+			event.Fields.Put("pulsar.content_encoding", encoding)
+		}
+		if in.config.Consumer.Decryption.ConsumeUndecrypted() && msg.GetEncryptionContext() != nil {
+			// decryption failed and the payload is forwarded as it was received
+			event.Fields.Put("pulsar.encrypted", true)
+		}
+		if sampleRate < 1 {
+			event.Fields.Put("pulsar.sample_rate", sampleRate)
+		}
+		if in.config.Consumer.TraceContext.Enabled {
+			putTraceContext(event.Fields, msg, pending.transaction)
+		}
+		if in.config.Consumer.Table.Enabled {
+			toTableEvent(event, msg)
+		}
+	}
+
+	in.client.PublishAll(events)
+
+	logp.Debug(selector, "%d events sent", len(events))
+}
+
+// inputFactory creates the inputs loaded from pulsarbeat.config.inputs. The
+// configuration files hold a list of consumer sections, sharing the clients
+// of the beat.
+type inputFactory struct {
+	bt *pulsarbeat
+}
+
+func (f *inputFactory) Create(p beat.PipelineConnector, cfg *common.Config) (cfgfile.Runner, error) {
+	in, err := f.newInput(p, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if err := in.subscribe(); err != nil {
+		return nil, err
+	}
+	return in, nil
+}
+
+func (f *inputFactory) CheckConfig(cfg *common.Config) error {
+	_, err := f.newInput(nil, cfg)
+	return err
+}
+
+func (f *inputFactory) newInput(p beat.PipelineConnector, cfg *common.Config) (*input, error) {
+	c := config.Config{Consumer: config.DefaultConfig.Consumer}
+	if err := cfg.Unpack(&c.Consumer); err != nil {
+		return nil, fmt.Errorf("error reading input config: %v", err)
+	}
+	return newInput(f.bt, p, c)
+}
@@ -0,0 +1,492 @@
+// +build !integration
+
+package beater
+
+import (
+	"context"
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/yukshimizu/pulsarbeat/config"
+	"go.elastic.co/apm"
+	"go.elastic.co/apm/apmtest"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testMessage is a received message with the given metadata. Other methods of
+// pulsar.Message are not implemented.
+type testMessage struct {
+	pulsar.Message
+	topic       string
+	key         string
+	orderingKey string
+	producer    string
+	properties  map[string]string
+	payload     []byte
+	publishTime time.Time
+	id          pulsar.MessageID
+}
+
+func (m *testMessage) Topic() string                 { return m.topic }
+func (m *testMessage) Key() string                   { return m.key }
+func (m *testMessage) OrderingKey() string           { return m.orderingKey }
+func (m *testMessage) ProducerName() string          { return m.producer }
+func (m *testMessage) Properties() map[string]string { return m.properties }
+func (m *testMessage) Payload() []byte               { return m.payload }
+func (m *testMessage) PublishTime() time.Time        { return m.publishTime }
+func (m *testMessage) ID() pulsar.MessageID          { return m.id }
+
+// testConsumer delivers messages from a channel and records the delivered
+// and acknowledged ones. Like the broker, it redelivers negatively acknowledged
+// messages. Other methods of pulsar.Consumer are not implemented.
+type testConsumer struct {
+	pulsar.Consumer
+	messages chan pulsar.Message
+
+	mu        sync.Mutex
+	delivered []pulsar.Message
+	acked     []pulsar.Message
+	seekedTo  interface{}
+	lastIDs   []pulsar.TopicMessageID
+}
+
+func newTestConsumer(messages ...pulsar.Message) *testConsumer {
+	c := &testConsumer{messages: make(chan pulsar.Message, len(messages))}
+	for _, msg := range messages {
+		c.messages <- msg
+	}
+	return c
+}
+
+func (c *testConsumer) Receive(ctx context.Context) (pulsar.Message, error) {
+	select {
+	case msg := <-c.messages:
+		c.mu.Lock()
+		c.delivered = append(c.delivered, msg)
+		c.mu.Unlock()
+		return msg, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (c *testConsumer) Nack(msg pulsar.Message) {
+	if msg != nil {
+		c.messages <- msg
+	}
+}
+
+// unacked returns the delivered messages which are not acknowledged yet, in
+// delivery order, as the broker redelivers them on an ack timeout.
+func (c *testConsumer) unacked() []pulsar.Message {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	acked := make(map[pulsar.Message]int)
+	for _, msg := range c.acked {
+		acked[msg]++
+	}
+	var unacked []pulsar.Message
+	for _, msg := range c.delivered {
+		if acked[msg] > 0 {
+			acked[msg]--
+			continue
+		}
+		unacked = append(unacked, msg)
+	}
+	return unacked
+}
+
+func (c *testConsumer) deliveredMessages() []pulsar.Message {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]pulsar.Message(nil), c.delivered...)
+}
+
+func (c *testConsumer) Ack(msg pulsar.Message) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.acked = append(c.acked, msg)
+	return nil
+}
+
+func (c *testConsumer) Close() {}
+
+func (c *testConsumer) GetLastMessageIDs() ([]pulsar.TopicMessageID, error) {
+	return c.lastIDs, nil
+}
+
+func (c *testConsumer) Name() string         { return "pulsarbeat-1" }
+func (c *testConsumer) Subscription() string { return "my-subscription" }
+
+// Seek and SeekByTime record the position the consumer was reset to.
+func (c *testConsumer) Seek(id pulsar.MessageID) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.seekedTo = id
+	return nil
+}
+
+func (c *testConsumer) SeekByTime(t time.Time) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.seekedTo = t
+	return nil
+}
+
+func (c *testConsumer) ackedMessages() []pulsar.Message {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]pulsar.Message(nil), c.acked...)
+}
+
+// testClient is a pipeline client whose output acknowledges the events as
+// soon as they are published, unless acknowledging is held. Like the clients
+// of the pipeline, it drops the held acknowledgements when it is closed.
+type testClient struct {
+	mu        sync.Mutex
+	published []beat.Event
+	hold      bool
+	held      []interface{}
+}
+
+func (c *testClient) Publish(event beat.Event) {
+	c.PublishAll([]beat.Event{event})
+}
+
+func (c *testClient) PublishAll(events []beat.Event) {
+	c.mu.Lock()
+	c.published = append(c.published, events...)
+	privates := make([]interface{}, 0, len(events))
+	for _, event := range events {
+		privates = append(privates, event.Private)
+	}
+	if c.hold {
+		c.held = append(c.held, privates...)
+		privates = nil
+	}
+	c.mu.Unlock()
+
+	if len(privates) != 0 {
+		ackEvents(len(privates), privates)
+	}
+}
+
+// release acknowledges the held events and stops holding further ones.
+func (c *testClient) release() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.hold = false
+	if len(c.held) != 0 {
+		ackEvents(len(c.held), c.held)
+	}
+	c.held = nil
+}
+
+func (c *testClient) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.held = nil
+	return nil
+}
+
+func (c *testClient) events() []beat.Event {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]beat.Event(nil), c.published...)
+}
+
+// testPipeline connects the testClient.
+type testPipeline struct {
+	client *testClient
+}
+
+func (p testPipeline) Connect() (beat.Client, error) {
+	return p.client, nil
+}
+
+func (p testPipeline) ConnectWith(beat.ClientConfig) (beat.Client, error) {
+	return p.client, nil
+}
+
+func testInput(t *testing.T, c config.Config) (*input, error) {
+	t.Helper()
+	bt := &pulsarbeat{
+		config:   c,
+		clusters: map[string]*cluster{"": {inputs: make(map[*input]struct{})}},
+	}
+	return newInput(bt, nil, c)
+}
+
+// waitFor polls condition until it holds or a timeout expires.
+func waitFor(t *testing.T, what string, condition func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(10 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatalf("Timed out waiting for %s", what)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestReceivePerKeyOrdering(t *testing.T) {
+	const (
+		numKeys     = 8
+		numMessages = 50
+	)
+
+	c := config.DefaultConfig
+	c.Consumer.Topic = "my-topic"
+	c.Consumer.PublishWorkers = 4
+	c.Consumer.Ordering = config.OrderingPerKey
+	in, err := testInput(t, c)
+	if err != nil {
+		t.Fatalf("Could not create input: %v", err)
+	}
+	client := &testClient{}
+	in.client = client
+
+	var messages []pulsar.Message
+	for seq := 0; seq < numMessages; seq++ {
+		for k := 0; k < numKeys; k++ {
+			key := "key-" + strconv.Itoa(k)
+			messages = append(messages, &testMessage{
+				topic:   "persistent://public/default/my-topic",
+				key:     key,
+				payload: []byte(key + "/" + strconv.Itoa(seq)),
+			})
+		}
+	}
+	consumer := newTestConsumer(messages...)
+	w := in.newWorker(consumer)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		in.receive(ctx, w, newHealthMonitor(0, client.Publish))
+	}()
+	waitFor(t, "messages to be acknowledged", func() bool {
+		return len(consumer.ackedMessages()) == len(messages)
+	})
+	cancel()
+	<-done
+	w.lanes.close()
+
+	published := make(map[string][]string)
+	for _, event := range client.events() {
+		key, _ := event.Fields.GetValue("pulsar.key")
+		message, _ := event.Fields.GetValue("message")
+		published[key.(string)] = append(published[key.(string)], message.(string))
+	}
+	acked := make(map[string][]string)
+	for _, msg := range consumer.ackedMessages() {
+		acked[msg.Key()] = append(acked[msg.Key()], string(msg.Payload()))
+	}
+
+	for k := 0; k < numKeys; k++ {
+		key := "key-" + strconv.Itoa(k)
+		for name, payloads := range map[string][]string{"published": published[key], "acknowledged": acked[key]} {
+			if len(payloads) != numMessages {
+				t.Errorf("Expected %d messages of %s to be %s, got %d", numMessages, key, name, len(payloads))
+				continue
+			}
+			for seq, payload := range payloads {
+				if want := key + "/" + strconv.Itoa(seq); payload != want {
+					t.Errorf("Messages of %s %s out of order: %s", key, name, strings.Join(payloads, ","))
+					break
+				}
+			}
+		}
+	}
+	if n := atomic.LoadInt64(&w.acked); n != int64(len(messages)) {
+		t.Errorf("Expected %d acknowledged messages, got %d", len(messages), n)
+	}
+}
+
+// TestReceivePerKeyOrderingRedelivery redelivers the unacknowledged messages
+// while later messages of the same keys arrive. A redelivered message is
+// published again in the order it is received, so the messages of a key are
+// published and acknowledged in delivery order, not in publish order.
+func TestReceivePerKeyOrderingRedelivery(t *testing.T) {
+	const (
+		numKeys     = 8
+		numMessages = 20
+	)
+
+	c := config.DefaultConfig
+	c.Consumer.Topic = "my-topic"
+	c.Consumer.PublishWorkers = 4
+	c.Consumer.Ordering = config.OrderingPerKey
+	in, err := testInput(t, c)
+	if err != nil {
+		t.Fatalf("Could not create input: %v", err)
+	}
+	client := &testClient{hold: true}
+	in.client = client
+
+	var first, later []pulsar.Message
+	for seq := 0; seq < numMessages; seq++ {
+		for k := 0; k < numKeys; k++ {
+			key := "key-" + strconv.Itoa(k)
+			msg := &testMessage{
+				topic:   "persistent://public/default/my-topic",
+				key:     key,
+				payload: []byte(key + "/" + strconv.Itoa(seq)),
+			}
+			if seq < numMessages/2 {
+				first = append(first, msg)
+			} else {
+				later = append(later, msg)
+			}
+		}
+	}
+	consumer := &testConsumer{messages: make(chan pulsar.Message, 2*len(first)+len(later))}
+	for _, msg := range first {
+		consumer.messages <- msg
+	}
+	w := in.newWorker(consumer)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		in.receive(ctx, w, newHealthMonitor(0, client.Publish))
+	}()
+	waitFor(t, "the first messages to be published", func() bool {
+		return len(client.events()) == len(first)
+	})
+
+	// the output has not acknowledged anything, so the first messages are
+	// redelivered, interleaved with the later messages of their keys, and some
+	// are negatively acknowledged on top
+	unacked := consumer.unacked()
+	for i := range unacked {
+		consumer.messages <- unacked[i]
+		if i < len(later) {
+			consumer.messages <- later[i]
+		}
+		if i%5 == 0 {
+			consumer.Nack(unacked[i])
+		}
+	}
+	client.release()
+
+	total := len(first) + len(unacked) + (len(unacked)+4)/5 + len(later)
+	waitFor(t, "messages to be acknowledged", func() bool {
+		return len(consumer.ackedMessages()) == total
+	})
+	cancel()
+	<-done
+	w.lanes.close()
+
+	delivered := make(map[string][]string)
+	for _, msg := range consumer.deliveredMessages() {
+		delivered[msg.Key()] = append(delivered[msg.Key()], string(msg.Payload()))
+	}
+	published := make(map[string][]string)
+	for _, event := range client.events() {
+		key, _ := event.Fields.GetValue("pulsar.key")
+		message, _ := event.Fields.GetValue("message")
+		published[key.(string)] = append(published[key.(string)], message.(string))
+	}
+	acked := make(map[string][]string)
+	for _, msg := range consumer.ackedMessages() {
+		acked[msg.Key()] = append(acked[msg.Key()], string(msg.Payload()))
+	}
+
+	for k := 0; k < numKeys; k++ {
+		key := "key-" + strconv.Itoa(k)
+		want := strings.Join(delivered[key], ",")
+		if got := strings.Join(published[key], ","); got != want {
+			t.Errorf("Messages of %s published out of delivery order:\n got %s\nwant %s", key, got, want)
+		}
+		if got := strings.Join(acked[key], ","); got != want {
+			t.Errorf("Messages of %s acknowledged out of delivery order:\n got %s\nwant %s", key, got, want)
+		}
+	}
+}
+
+func TestTableModeCodecs(t *testing.T) {
+	tests := []struct {
+		codec   map[string]interface{}
+		wantErr bool
+	}{
+		{codec: nil},
+		{codec: map[string]interface{}{"type": "plain"}},
+		{codec: map[string]interface{}{"type": "cloudevents"}},
+		{codec: map[string]interface{}{"type": "otlp"}, wantErr: true},
+		{codec: map[string]interface{}{"type": "prometheus", "group_by": "family"}, wantErr: true},
+	}
+
+	for _, test := range tests {
+		c := config.DefaultConfig
+		c.Consumer.Topic = "my-topic"
+		c.Consumer.Table.Enabled = true
+		if test.codec != nil {
+			c.Consumer.Codec = common.MustNewConfigFrom(test.codec)
+		}
+		_, err := testInput(t, c)
+		if (err != nil) != test.wantErr {
+			t.Errorf("Codec %v in table mode: expected error %v, got %v", test.codec, test.wantErr, err)
+		}
+	}
+}
+
+// testInstrumentation is the instrumentation of a beat with the given tracer.
+type testInstrumentation struct {
+	tracer *apm.Tracer
+}
+
+func (i testInstrumentation) Tracer() *apm.Tracer    { return i.tracer }
+func (i testInstrumentation) Listener() net.Listener { return nil }
+
+func TestInputTransactions(t *testing.T) {
+	tracer := apmtest.DiscardTracer
+
+	tests := []struct {
+		name            string
+		instrumentation bool
+		mainSection     bool
+		input           bool
+		want            bool
+	}{
+		{name: "disabled"},
+		{name: "enabled by the input", instrumentation: true, input: true, want: true},
+		{name: "enabled by the main section only", instrumentation: true, mainSection: true},
+		{name: "without instrumentation", input: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := config.DefaultConfig
+			c.Consumer.Enabled = false
+			c.Consumer.TraceContext.Transactions = test.mainSection
+			bt := &pulsarbeat{
+				config:   c,
+				clusters: map[string]*cluster{"": {inputs: make(map[*input]struct{})}},
+			}
+			if test.instrumentation {
+				bt.instrumentation = testInstrumentation{tracer: tracer}
+			}
+
+			cfg := common.MustNewConfigFrom(map[string]interface{}{
+				"topic":         "my-topic",
+				"trace_context": map[string]interface{}{"transactions": test.input},
+			})
+			in, err := (&inputFactory{bt: bt}).newInput(nil, cfg)
+			if err != nil {
+				t.Fatalf("Could not create input: %v", err)
+			}
+			if got := in.tracer != nil; got != test.want {
+				t.Errorf("Expected transactions %v, got %v", test.want, got)
+			}
+		})
+	}
+}
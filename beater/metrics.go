@@ -0,0 +1,9 @@
+package beater
+
+import (
+	"github.com/elastic/beats/v7/libbeat/monitoring"
+)
+
+// metricsRegistry holds the pulsarbeat specific metrics, reported by the beat's
+// HTTP endpoint and internal monitoring.
+var metricsRegistry = monitoring.Default.NewRegistry("pulsarbeat")
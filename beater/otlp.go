@@ -0,0 +1,264 @@
+package beater
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"strings"
+	"time"
+)
+
+const (
+	otlpSignalLogs   = "logs"
+	otlpSignalTraces = "traces"
+)
+
+// otlpToECS maps OpenTelemetry resource and scope attributes to ECS fields.
+// Other attributes are kept in otel.resource.attributes and
+// otel.scope.attributes.
+var otlpToECS = map[string]string{
+	"service.name":            "service.name",
+	"service.version":         "service.version",
+	"service.instance.id":     "service.node.name",
+	"deployment.environment":  "service.environment",
+	"host.name":               "host.name",
+	"host.id":                 "host.id",
+	"host.arch":               "host.architecture",
+	"os.type":                 "host.os.type",
+	"os.version":              "host.os.version",
+	"cloud.provider":          "cloud.provider",
+	"cloud.region":            "cloud.region",
+	"cloud.availability_zone": "cloud.availability_zone",
+	"cloud.account.id":        "cloud.account.id",
+	"container.id":            "container.id",
+	"container.name":          "container.name",
+	"container.image.name":    "container.image.name",
+	"k8s.namespace.name":      "kubernetes.namespace",
+	"k8s.pod.name":            "kubernetes.pod.name",
+	"k8s.pod.uid":             "kubernetes.pod.uid",
+	"k8s.node.name":           "kubernetes.node.name",
+	"process.pid":             "process.pid",
+}
+
+// otlpCodec decodes OTLP export requests, as written by the pulsar exporter of
+// the OpenTelemetry collector, into one event per log record or span.
+type otlpCodec struct {
+	signal string
+	json   bool
+}
+
+func newOTLPCodec(cfg *common.Config) (codec, error) {
+	settings := struct {
+		Signal   string `config:"signal"`
+		Encoding string `config:"encoding"`
+	}{Signal: otlpSignalLogs, Encoding: "proto"}
+	if err := cfg.Unpack(&settings); err != nil {
+		return nil, err
+	}
+
+	if settings.Signal != otlpSignalLogs && settings.Signal != otlpSignalTraces {
+		return nil, fmt.Errorf("unknown otlp signal: %s", settings.Signal)
+	}
+	if settings.Encoding != "proto" && settings.Encoding != "json" {
+		return nil, fmt.Errorf("unknown otlp encoding: %s", settings.Encoding)
+	}
+	return &otlpCodec{signal: settings.Signal, json: settings.Encoding == "json"}, nil
+}
+
+func (c *otlpCodec) unmarshal(payload []byte, m proto.Message) error {
+	if c.json {
+		return protojson.Unmarshal(payload, m)
+	}
+	return proto.Unmarshal(payload, m)
+}
+
+func (c *otlpCodec) decode(_ pulsar.Message, payload []byte) ([]beat.Event, error) {
+	if c.signal == otlpSignalTraces {
+		return c.decodeTraces(payload)
+	}
+	return c.decodeLogs(payload)
+}
+
+func (c *otlpCodec) decodeLogs(payload []byte) ([]beat.Event, error) {
+	var request collogspb.ExportLogsServiceRequest
+	if err := c.unmarshal(payload, &request); err != nil {
+		return nil, err
+	}
+
+	var events []beat.Event
+	for _, resourceLogs := range request.GetResourceLogs() {
+		resource := otlpAttributes(resourceLogs.GetResource().GetAttributes(), "otel.resource.attributes")
+		for _, scopeLogs := range resourceLogs.GetScopeLogs() {
+			scope := otlpScope(scopeLogs.GetScope())
+			for _, record := range scopeLogs.GetLogRecords() {
+				fields := common.MapStr{}
+				fields.DeepUpdate(resource.Clone())
+				fields.DeepUpdate(scope.Clone())
+				if name := scopeLogs.GetScope().GetName(); name != "" {
+					fields.Put("log.logger", name)
+				}
+
+				if body := otlpValue(record.GetBody()); body != nil {
+					if message, ok := body.(string); ok {
+						fields.Put("message", message)
+					} else {
+						fields.Put("otel.body", body)
+					}
+				}
+				if level := record.GetSeverityText(); level != "" {
+					fields.Put("log.level", level)
+				}
+				if severity := record.GetSeverityNumber(); severity != 0 {
+					fields.Put("event.severity", int(severity))
+				}
+				putOTLPIDs(fields, record.GetTraceId(), record.GetSpanId(), nil)
+				if attributes := otlpAttributes(record.GetAttributes(), "otel.attributes"); len(attributes) != 0 {
+					fields.DeepUpdate(attributes)
+				}
+
+				timestamp := record.GetTimeUnixNano()
+				if timestamp == 0 {
+					timestamp = record.GetObservedTimeUnixNano()
+				}
+				events = append(events, beat.Event{
+					Timestamp: otlpTime(timestamp),
+					Fields:    fields,
+				})
+			}
+		}
+	}
+	return events, nil
+}
+
+func (c *otlpCodec) decodeTraces(payload []byte) ([]beat.Event, error) {
+	var request coltracepb.ExportTraceServiceRequest
+	if err := c.unmarshal(payload, &request); err != nil {
+		return nil, err
+	}
+
+	var events []beat.Event
+	for _, resourceSpans := range request.GetResourceSpans() {
+		resource := otlpAttributes(resourceSpans.GetResource().GetAttributes(), "otel.resource.attributes")
+		for _, scopeSpans := range resourceSpans.GetScopeSpans() {
+			scope := otlpScope(scopeSpans.GetScope())
+			for _, span := range scopeSpans.GetSpans() {
+				fields := common.MapStr{}
+				fields.DeepUpdate(resource.Clone())
+				fields.DeepUpdate(scope.Clone())
+
+				fields.Put("span.name", span.GetName())
+				fields.Put("otel.span.kind", strings.ToLower(strings.TrimPrefix(span.GetKind().String(), "SPAN_KIND_")))
+				putOTLPIDs(fields, span.GetTraceId(), span.GetSpanId(), span.GetParentSpanId())
+				if end := span.GetEndTimeUnixNano(); end >= span.GetStartTimeUnixNano() {
+					fields.Put("event.duration", int64(end-span.GetStartTimeUnixNano()))
+				}
+				switch span.GetStatus().GetCode() {
+				case tracepb.Status_STATUS_CODE_OK:
+					fields.Put("event.outcome", "success")
+				case tracepb.Status_STATUS_CODE_ERROR:
+					fields.Put("event.outcome", "failure")
+					if message := span.GetStatus().GetMessage(); message != "" {
+						fields.Put("error.message", message)
+					}
+				}
+				if attributes := otlpAttributes(span.GetAttributes(), "otel.attributes"); len(attributes) != 0 {
+					fields.DeepUpdate(attributes)
+				}
+
+				events = append(events, beat.Event{
+					Timestamp: otlpTime(span.GetStartTimeUnixNano()),
+					Fields:    fields,
+				})
+			}
+		}
+	}
+	return events, nil
+}
+
+// otlpScope returns the fields of an instrumentation scope.
+func otlpScope(scope *commonpb.InstrumentationScope) common.MapStr {
+	fields := otlpAttributes(scope.GetAttributes(), "otel.scope.attributes")
+	if name := scope.GetName(); name != "" {
+		fields.Put("otel.scope.name", name)
+	}
+	if version := scope.GetVersion(); version != "" {
+		fields.Put("otel.scope.version", version)
+	}
+	return fields
+}
+
+// otlpAttributes maps attributes with an ECS equivalent to ECS fields and puts
+// all others below target.
+func otlpAttributes(attributes []*commonpb.KeyValue, target string) common.MapStr {
+	fields := common.MapStr{}
+	others := common.MapStr{}
+	for _, attribute := range attributes {
+		value := otlpValue(attribute.GetValue())
+		if field, ok := otlpToECS[attribute.GetKey()]; ok {
+			fields.Put(field, value)
+		} else {
+			others[attribute.GetKey()] = value
+		}
+	}
+	if len(others) != 0 {
+		fields.Put(target, others)
+	}
+	return fields
+}
+
+func otlpValue(value *commonpb.AnyValue) interface{} {
+	switch v := value.GetValue().(type) {
+	case *commonpb.AnyValue_StringValue:
+		return v.StringValue
+	case *commonpb.AnyValue_BoolValue:
+		return v.BoolValue
+	case *commonpb.AnyValue_IntValue:
+		return v.IntValue
+	case *commonpb.AnyValue_DoubleValue:
+		return v.DoubleValue
+	case *commonpb.AnyValue_BytesValue:
+		return base64.StdEncoding.EncodeToString(v.BytesValue)
+	case *commonpb.AnyValue_ArrayValue:
+		var values []interface{}
+		for _, element := range v.ArrayValue.GetValues() {
+			values = append(values, otlpValue(element))
+		}
+		return values
+	case *commonpb.AnyValue_KvlistValue:
+		values := common.MapStr{}
+		for _, kv := range v.KvlistValue.GetValues() {
+			values[kv.GetKey()] = otlpValue(kv.GetValue())
+		}
+		return values
+	}
+	return nil
+}
+
+func putOTLPIDs(fields common.MapStr, traceID, spanID, parentID []byte) {
+	if len(traceID) != 0 {
+		fields.Put("trace.id", hex.EncodeToString(traceID))
+	}
+	if len(spanID) != 0 {
+		fields.Put("span.id", hex.EncodeToString(spanID))
+	}
+	if len(parentID) != 0 {
+		fields.Put("parent.id", hex.EncodeToString(parentID))
+	}
+}
+
+// otlpTime converts nanoseconds since epoch, where 0 means unknown.
+func otlpTime(nanos uint64) time.Time {
+	if nanos == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, int64(nanos)).UTC()
+}
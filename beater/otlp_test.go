@@ -0,0 +1,227 @@
+// +build !integration
+
+package beater
+
+import (
+	"github.com/elastic/beats/v7/libbeat/common"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func stringValue(s string) *commonpb.AnyValue {
+	return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: s}}
+}
+
+func testResource() *resourcepb.Resource {
+	return &resourcepb.Resource{Attributes: []*commonpb.KeyValue{
+		{Key: "service.name", Value: stringValue("checkout")},
+		{Key: "k8s.pod.name", Value: stringValue("checkout-1")},
+		{Key: "team", Value: stringValue("payments")},
+	}}
+}
+
+func testScope() *commonpb.InstrumentationScope {
+	return &commonpb.InstrumentationScope{Name: "io.opentelemetry.checkout", Version: "1.2.0"}
+}
+
+func TestOTLPLogs(t *testing.T) {
+	request := &collogspb.ExportLogsServiceRequest{ResourceLogs: []*logspb.ResourceLogs{{
+		Resource: testResource(),
+		ScopeLogs: []*logspb.ScopeLogs{{
+			Scope: testScope(),
+			LogRecords: []*logspb.LogRecord{
+				{
+					TimeUnixNano:   1622548800000000000,
+					SeverityText:   "ERROR",
+					SeverityNumber: logspb.SeverityNumber_SEVERITY_NUMBER_ERROR,
+					Body:           stringValue("payment failed"),
+					TraceId:        []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10},
+					SpanId:         []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+					Attributes: []*commonpb.KeyValue{
+						{Key: "order.id", Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: 42}}},
+					},
+				},
+				{
+					ObservedTimeUnixNano: 1622548801000000000,
+					Body: &commonpb.AnyValue{Value: &commonpb.AnyValue_KvlistValue{KvlistValue: &commonpb.KeyValueList{
+						Values: []*commonpb.KeyValue{{Key: "amount", Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_DoubleValue{DoubleValue: 9.5}}}},
+					}}},
+				},
+			},
+		}},
+	}}}
+
+	resource := common.MapStr{
+		"service":    common.MapStr{"name": "checkout"},
+		"kubernetes": common.MapStr{"pod": common.MapStr{"name": "checkout-1"}},
+		"otel": common.MapStr{
+			"resource": common.MapStr{"attributes": common.MapStr{"team": "payments"}},
+			"scope":    common.MapStr{"name": "io.opentelemetry.checkout", "version": "1.2.0"},
+		},
+		"log": common.MapStr{"logger": "io.opentelemetry.checkout"},
+	}
+	first := resource.Clone()
+	first.DeepUpdate(common.MapStr{
+		"message": "payment failed",
+		"log":     common.MapStr{"level": "ERROR"},
+		"event":   common.MapStr{"severity": 17},
+		"trace":   common.MapStr{"id": "0102030405060708090a0b0c0d0e0f10"},
+		"span":    common.MapStr{"id": "0102030405060708"},
+		"otel":    common.MapStr{"attributes": common.MapStr{"order.id": int64(42)}},
+	})
+	second := resource.Clone()
+	second.DeepUpdate(common.MapStr{
+		"otel": common.MapStr{"body": common.MapStr{"amount": 9.5}},
+	})
+
+	for _, encoding := range []string{"proto", "json"} {
+		t.Run(encoding, func(t *testing.T) {
+			c, err := newOTLPCodec(common.MustNewConfigFrom(map[string]interface{}{
+				"signal": "logs", "encoding": encoding,
+			}))
+			if err != nil {
+				t.Fatalf("Could not create codec: %v", err)
+			}
+			events, err := c.decode(nil, marshalOTLP(t, request, encoding))
+			if err != nil {
+				t.Fatalf("Could not decode logs: %v", err)
+			}
+			if len(events) != 2 {
+				t.Fatalf("Expected 2 events, got %d", len(events))
+			}
+
+			if want := time.Unix(0, 1622548800000000000).UTC(); !events[0].Timestamp.Equal(want) {
+				t.Errorf("Expected timestamp %v, got %v", want, events[0].Timestamp)
+			}
+			if want := time.Unix(0, 1622548801000000000).UTC(); !events[1].Timestamp.Equal(want) {
+				t.Errorf("Expected the observed time %v, got %v", want, events[1].Timestamp)
+			}
+			for i, want := range []common.MapStr{first, second} {
+				if !reflect.DeepEqual(events[i].Fields, want) {
+					t.Errorf("Event %d: expected fields\n%v\ngot\n%v", i, want.StringToPrint(), events[i].Fields.StringToPrint())
+				}
+			}
+		})
+	}
+}
+
+func TestOTLPTraces(t *testing.T) {
+	request := &coltracepb.ExportTraceServiceRequest{ResourceSpans: []*tracepb.ResourceSpans{{
+		Resource: testResource(),
+		ScopeSpans: []*tracepb.ScopeSpans{{
+			Scope: testScope(),
+			Spans: []*tracepb.Span{
+				{
+					TraceId:           []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10},
+					SpanId:            []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+					ParentSpanId:      []byte{0x08, 0x07, 0x06, 0x05, 0x04, 0x03, 0x02, 0x01},
+					Name:              "charge",
+					Kind:              tracepb.Span_SPAN_KIND_CLIENT,
+					StartTimeUnixNano: 1622548800000000000,
+					EndTimeUnixNano:   1622548800250000000,
+					Status:            &tracepb.Status{Code: tracepb.Status_STATUS_CODE_ERROR, Message: "card declined"},
+				},
+				{
+					Name:              "validate",
+					Kind:              tracepb.Span_SPAN_KIND_INTERNAL,
+					StartTimeUnixNano: 1622548800000000000,
+					EndTimeUnixNano:   1622548800001000000,
+					Status:            &tracepb.Status{Code: tracepb.Status_STATUS_CODE_OK},
+				},
+			},
+		}},
+	}}}
+
+	resource := common.MapStr{
+		"service":    common.MapStr{"name": "checkout"},
+		"kubernetes": common.MapStr{"pod": common.MapStr{"name": "checkout-1"}},
+		"otel": common.MapStr{
+			"resource": common.MapStr{"attributes": common.MapStr{"team": "payments"}},
+			"scope":    common.MapStr{"name": "io.opentelemetry.checkout", "version": "1.2.0"},
+		},
+	}
+	first := resource.Clone()
+	first.DeepUpdate(common.MapStr{
+		"span":   common.MapStr{"name": "charge", "id": "0102030405060708"},
+		"trace":  common.MapStr{"id": "0102030405060708090a0b0c0d0e0f10"},
+		"parent": common.MapStr{"id": "0807060504030201"},
+		"otel":   common.MapStr{"span": common.MapStr{"kind": "client"}},
+		"event":  common.MapStr{"duration": int64(250000000), "outcome": "failure"},
+		"error":  common.MapStr{"message": "card declined"},
+	})
+	second := resource.Clone()
+	second.DeepUpdate(common.MapStr{
+		"span":  common.MapStr{"name": "validate"},
+		"otel":  common.MapStr{"span": common.MapStr{"kind": "internal"}},
+		"event": common.MapStr{"duration": int64(1000000), "outcome": "success"},
+	})
+
+	for _, encoding := range []string{"proto", "json"} {
+		t.Run(encoding, func(t *testing.T) {
+			c, err := newOTLPCodec(common.MustNewConfigFrom(map[string]interface{}{
+				"signal": "traces", "encoding": encoding,
+			}))
+			if err != nil {
+				t.Fatalf("Could not create codec: %v", err)
+			}
+			events, err := c.decode(nil, marshalOTLP(t, request, encoding))
+			if err != nil {
+				t.Fatalf("Could not decode traces: %v", err)
+			}
+			if len(events) != 2 {
+				t.Fatalf("Expected 2 events, got %d", len(events))
+			}
+			if want := time.Unix(0, 1622548800000000000).UTC(); !events[0].Timestamp.Equal(want) {
+				t.Errorf("Expected the start time %v, got %v", want, events[0].Timestamp)
+			}
+			for i, want := range []common.MapStr{first, second} {
+				if !reflect.DeepEqual(events[i].Fields, want) {
+					t.Errorf("Event %d: expected fields\n%v\ngot\n%v", i, want.StringToPrint(), events[i].Fields.StringToPrint())
+				}
+			}
+		})
+	}
+}
+
+func TestOTLPCodecErrors(t *testing.T) {
+	for _, settings := range []map[string]interface{}{
+		{"signal": "metrics"},
+		{"encoding": "xml"},
+	} {
+		if _, err := newOTLPCodec(common.MustNewConfigFrom(settings)); err == nil {
+			t.Errorf("Expected an error for %v", settings)
+		}
+	}
+
+	c, err := newOTLPCodec(common.NewConfig())
+	if err != nil {
+		t.Fatalf("Could not create codec: %v", err)
+	}
+	if _, err := c.decode(nil, []byte("not protobuf")); err == nil {
+		t.Error("Expected an error for an invalid payload")
+	}
+}
+
+func marshalOTLP(t *testing.T, m proto.Message, encoding string) []byte {
+	t.Helper()
+	var payload []byte
+	var err error
+	if encoding == "json" {
+		payload, err = protojson.Marshal(m)
+	} else {
+		payload, err = proto.Marshal(m)
+	}
+	if err != nil {
+		t.Fatalf("Could not marshal request: %v", err)
+	}
+	return payload
+}
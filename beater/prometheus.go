@@ -0,0 +1,246 @@
+package beater
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/pkg/textparse"
+	"github.com/yukshimizu/pulsarbeat/config"
+	"io"
+	"math"
+	"mime"
+	"time"
+)
+
+const (
+	prometheusContentType  = "text/plain"
+	openMetricsContentType = "application/openmetrics-text"
+)
+
+// prometheusSuffixes are the suffixes of the samples belonging to a metric
+// family, e.g. the buckets of a histogram or the total of an OpenMetrics
+// counter.
+var prometheusSuffixes = []string{"_total", "_created", "_bucket", "_count", "_sum", "_info", "_gcount", "_gsum"}
+
+// prometheusCodec parses payloads in the Prometheus text exposition format or
+// in the OpenMetrics text format into one event per sample or per metric
+// family. In auto format, OpenMetrics is selected by the content-type property
+// of the message. Samples are timestamped with their own timestamp if they
+// have one, and with the publish time of the message otherwise.
+type prometheusCodec struct {
+	perFamily bool
+	format    string
+}
+
+type prometheusFamily struct {
+	name    string
+	typ     string
+	help    string
+	unit    string
+	samples []prometheusSample
+}
+
+type prometheusSample struct {
+	name      string
+	labels    common.MapStr
+	value     float64
+	timestamp time.Time
+}
+
+func newPrometheusCodec(cfg *common.Config) (codec, error) {
+	settings := struct {
+		GroupBy string `config:"group_by"`
+		Format  string `config:"format"`
+	}{GroupBy: config.PrometheusGroupBySample, Format: config.PrometheusFormatAuto}
+	if err := cfg.Unpack(&settings); err != nil {
+		return nil, err
+	}
+
+	if settings.GroupBy != config.PrometheusGroupBySample && settings.GroupBy != config.PrometheusGroupByFamily {
+		return nil, fmt.Errorf("unknown prometheus group_by: %s", settings.GroupBy)
+	}
+	switch settings.Format {
+	case config.PrometheusFormatAuto, config.PrometheusFormatPrometheus, config.PrometheusFormatOpenMetrics:
+	default:
+		return nil, fmt.Errorf("unknown prometheus format: %s", settings.Format)
+	}
+	return &prometheusCodec{
+		perFamily: settings.GroupBy == config.PrometheusGroupByFamily,
+		format:    settings.Format,
+	}, nil
+}
+
+// openMetrics reports whether the payload of msg is parsed as OpenMetrics.
+func (c *prometheusCodec) openMetrics(msg pulsar.Message) bool {
+	switch c.format {
+	case config.PrometheusFormatOpenMetrics:
+		return true
+	case config.PrometheusFormatPrometheus:
+		return false
+	}
+	mediaType, _, err := mime.ParseMediaType(msg.Properties()[contentTypeProperty])
+	return err == nil && mediaType == openMetricsContentType
+}
+
+func (c *prometheusCodec) decode(msg pulsar.Message, payload []byte) ([]beat.Event, error) {
+	families, err := parsePrometheus(payload, c.openMetrics(msg))
+	if err != nil {
+		return nil, err
+	}
+
+	var events []beat.Event
+	for _, family := range families {
+		if len(family.samples) == 0 {
+			continue
+		}
+		if c.perFamily {
+			events = append(events, family.event(msg.PublishTime()))
+			continue
+		}
+		for _, sample := range family.samples {
+			events = append(events, family.sampleEvent(sample, msg.PublishTime()))
+		}
+	}
+	return events, nil
+}
+
+func (f *prometheusFamily) fields() common.MapStr {
+	fields := common.MapStr{"name": f.name, "type": f.typ}
+	if f.help != "" {
+		fields["help"] = f.help
+	}
+	if f.unit != "" {
+		fields["unit"] = f.unit
+	}
+	return fields
+}
+
+func (f *prometheusFamily) sampleEvent(sample prometheusSample, publishTime time.Time) beat.Event {
+	prometheus := common.MapStr{
+		"family":  f.fields(),
+		"metrics": common.MapStr{sample.name: sample.value},
+	}
+	if len(sample.labels) != 0 {
+		prometheus["labels"] = sample.labels
+	}
+
+	timestamp := sample.timestamp
+	if timestamp.IsZero() {
+		timestamp = publishTime
+	}
+	return beat.Event{
+		Timestamp: timestamp,
+		Fields:    common.MapStr{"prometheus": prometheus},
+	}
+}
+
+func (f *prometheusFamily) event(publishTime time.Time) beat.Event {
+	samples := make([]common.MapStr, 0, len(f.samples))
+	for _, sample := range f.samples {
+		s := common.MapStr{"name": sample.name, "value": sample.value}
+		if len(sample.labels) != 0 {
+			s["labels"] = sample.labels
+		}
+		if !sample.timestamp.IsZero() {
+			s["timestamp"] = sample.timestamp
+		}
+		samples = append(samples, s)
+	}
+	return beat.Event{
+		Timestamp: publishTime,
+		Fields: common.MapStr{
+			"prometheus": common.MapStr{
+				"family":  f.fields(),
+				"samples": samples,
+			},
+		},
+	}
+}
+
+// parsePrometheus parses a text exposition with the parser of the given
+// format. Samples with a NaN or infinite value are skipped as they can not be
+// indexed, and so are exemplars.
+func parsePrometheus(payload []byte, openMetrics bool) ([]*prometheusFamily, error) {
+	contentType := prometheusContentType
+	if openMetrics {
+		contentType = openMetricsContentType
+	}
+	// the parsers expect every line to be terminated
+	if !bytes.HasSuffix(payload, []byte("\n")) {
+		payload = append(payload[:len(payload):len(payload)], '\n')
+	}
+	parser := textparse.New(payload, contentType)
+
+	var families []*prometheusFamily
+	var current *prometheusFamily
+	family := func(name string) *prometheusFamily {
+		if current == nil || current.name != name {
+			current = &prometheusFamily{name: name, typ: string(textparse.MetricTypeUnknown)}
+			families = append(families, current)
+		}
+		return current
+	}
+
+	for {
+		entry, err := parser.Next()
+		if err == io.EOF {
+			return families, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch entry {
+		case textparse.EntryHelp:
+			name, help := parser.Help()
+			family(string(name)).help = string(help)
+		case textparse.EntryType:
+			name, typ := parser.Type()
+			family(string(name)).typ = string(typ)
+		case textparse.EntryUnit:
+			name, unit := parser.Unit()
+			family(string(name)).unit = string(unit)
+		case textparse.EntrySeries:
+			_, ts, value := parser.Series()
+			var lset labels.Labels
+			parser.Metric(&lset)
+
+			sample := prometheusSample{value: value}
+			for _, l := range lset {
+				if l.Name == labels.MetricName {
+					sample.name = l.Value
+					continue
+				}
+				if sample.labels == nil {
+					sample.labels = common.MapStr{}
+				}
+				sample.labels[l.Name] = l.Value
+			}
+			if ts != nil {
+				sample.timestamp = time.Unix(0, *ts*int64(time.Millisecond)).UTC()
+			}
+
+			if current == nil || !belongsToFamily(sample.name, current.name) {
+				family(sample.name)
+			}
+			if !math.IsNaN(sample.value) && !math.IsInf(sample.value, 0) {
+				current.samples = append(current.samples, sample)
+			}
+		}
+	}
+}
+
+func belongsToFamily(sample, family string) bool {
+	if sample == family {
+		return true
+	}
+	for _, suffix := range prometheusSuffixes {
+		if sample == family+suffix {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,292 @@
+// +build !integration
+
+package beater
+
+import (
+	"github.com/elastic/beats/v7/libbeat/common"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParsePrometheus(t *testing.T) {
+	tests := []struct {
+		name        string
+		payload     string
+		openMetrics bool
+		want        []*prometheusFamily
+		wantErr     bool
+	}{
+		{
+			name: "counter with timestamps",
+			payload: `# HELP http_requests_total The total number of HTTP requests.
+# TYPE http_requests_total counter
+http_requests_total{method="post",code="200"} 1027 1395066363000
+http_requests_total{method="post",code="400"} 3 1395066363000
+`,
+			want: []*prometheusFamily{{
+				name: "http_requests_total",
+				typ:  "counter",
+				help: "The total number of HTTP requests.",
+				samples: []prometheusSample{
+					{
+						name:      "http_requests_total",
+						labels:    common.MapStr{"method": "post", "code": "200"},
+						value:     1027,
+						timestamp: time.Unix(1395066363, 0).UTC(),
+					},
+					{
+						name:      "http_requests_total",
+						labels:    common.MapStr{"method": "post", "code": "400"},
+						value:     3,
+						timestamp: time.Unix(1395066363, 0).UTC(),
+					},
+				},
+			}},
+		},
+		{
+			name: "escaped help and label values",
+			payload: `# HELP msdos_file_access_time_seconds Access time with \\ and \n in help.
+# TYPE msdos_file_access_time_seconds gauge
+msdos_file_access_time_seconds{path="C:\\DIR\\FILE.TXT",error="Cannot find file:\n\"FILE.TXT\""} 1.458255915e9`,
+			want: []*prometheusFamily{{
+				name: "msdos_file_access_time_seconds",
+				typ:  "gauge",
+				help: "Access time with \\ and \n in help.",
+				samples: []prometheusSample{{
+					name:   "msdos_file_access_time_seconds",
+					labels: common.MapStr{"path": `C:\DIR\FILE.TXT`, "error": "Cannot find file:\n\"FILE.TXT\""},
+					value:  1.458255915e9,
+				}},
+			}},
+		},
+		{
+			name: "histogram",
+			payload: `# TYPE http_request_duration_seconds histogram
+http_request_duration_seconds_bucket{le="0.05"} 24054
+http_request_duration_seconds_bucket{le="0.1"} 33444
+http_request_duration_seconds_bucket{le="+Inf"} 144320
+http_request_duration_seconds_sum 53423
+http_request_duration_seconds_count 144320
+`,
+			want: []*prometheusFamily{{
+				name: "http_request_duration_seconds",
+				typ:  "histogram",
+				samples: []prometheusSample{
+					{name: "http_request_duration_seconds_bucket", labels: common.MapStr{"le": "0.05"}, value: 24054},
+					{name: "http_request_duration_seconds_bucket", labels: common.MapStr{"le": "0.1"}, value: 33444},
+					{name: "http_request_duration_seconds_bucket", labels: common.MapStr{"le": "+Inf"}, value: 144320},
+					{name: "http_request_duration_seconds_sum", value: 53423},
+					{name: "http_request_duration_seconds_count", value: 144320},
+				},
+			}},
+		},
+		{
+			name: "summary and untyped",
+			payload: `# TYPE rpc_duration_seconds summary
+rpc_duration_seconds{quantile="0.5"} 4773
+rpc_duration_seconds{quantile="0.99"} NaN
+rpc_duration_seconds_sum 1.7560473e+07
+rpc_duration_seconds_count 2693
+metric_without_type 12.47
+`,
+			want: []*prometheusFamily{
+				{
+					name: "rpc_duration_seconds",
+					typ:  "summary",
+					samples: []prometheusSample{
+						{name: "rpc_duration_seconds", labels: common.MapStr{"quantile": "0.5"}, value: 4773},
+						{name: "rpc_duration_seconds_sum", value: 1.7560473e+07},
+						{name: "rpc_duration_seconds_count", value: 2693},
+					},
+				},
+				{
+					name:    "metric_without_type",
+					typ:     "unknown",
+					samples: []prometheusSample{{name: "metric_without_type", value: 12.47}},
+				},
+			},
+		},
+		{
+			name: "openmetrics",
+			payload: `# TYPE process_cpu_seconds counter
+# UNIT process_cpu_seconds seconds
+# HELP process_cpu_seconds Total user and system CPU time.
+process_cpu_seconds_total 4.2 1520879607.5
+process_cpu_seconds_created 1520430000.0
+# TYPE request_duration_seconds histogram
+request_duration_seconds_bucket{le="0.1"} 8 # {trace_id="KOO5S4vxi0o"} 0.067
+request_duration_seconds_bucket{le="+Inf"} 17
+request_duration_seconds_count 17
+request_duration_seconds_sum 324789.3
+# EOF
+`,
+			openMetrics: true,
+			want: []*prometheusFamily{
+				{
+					name: "process_cpu_seconds",
+					typ:  "counter",
+					unit: "seconds",
+					help: "Total user and system CPU time.",
+					samples: []prometheusSample{
+						{
+							name:      "process_cpu_seconds_total",
+							value:     4.2,
+							timestamp: time.Unix(1520879607, int64(500*time.Millisecond)).UTC(),
+						},
+						{name: "process_cpu_seconds_created", value: 1520430000},
+					},
+				},
+				{
+					name: "request_duration_seconds",
+					typ:  "histogram",
+					samples: []prometheusSample{
+						{name: "request_duration_seconds_bucket", labels: common.MapStr{"le": "0.1"}, value: 8},
+						{name: "request_duration_seconds_bucket", labels: common.MapStr{"le": "+Inf"}, value: 17},
+						{name: "request_duration_seconds_count", value: 17},
+						{name: "request_duration_seconds_sum", value: 324789.3},
+					},
+				},
+			},
+		},
+		{
+			name:        "openmetrics without EOF",
+			payload:     "# TYPE up gauge\nup 1\n",
+			openMetrics: true,
+			wantErr:     true,
+		},
+		{
+			name:    "invalid value",
+			payload: "up one\n",
+			wantErr: true,
+		},
+		{
+			name:    "unterminated label value",
+			payload: `up{job="pulsar} 1`,
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			families, err := parsePrometheus([]byte(test.payload), test.openMetrics)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if test.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(families, test.want) {
+				t.Errorf("Expected families")
+				for _, f := range test.want {
+					t.Logf("  %+v", *f)
+				}
+				t.Errorf("got")
+				for _, f := range families {
+					t.Logf("  %+v", *f)
+				}
+			}
+		})
+	}
+}
+
+func TestPrometheusCodecFormat(t *testing.T) {
+	// OpenMetrics timestamps are seconds, Prometheus timestamps milliseconds
+	payload := []byte("up 1 1520879607\n# EOF\n")
+	seconds := time.Unix(1520879607, 0).UTC()
+	milliseconds := time.Unix(0, 1520879607*int64(time.Millisecond)).UTC()
+
+	tests := []struct {
+		format      string
+		contentType string
+		want        time.Time
+	}{
+		{format: "auto", want: milliseconds},
+		{format: "auto", contentType: "text/plain; version=0.0.4", want: milliseconds},
+		{format: "auto", contentType: "application/openmetrics-text; version=1.0.0; charset=utf-8", want: seconds},
+		{format: "prometheus", contentType: "application/openmetrics-text", want: milliseconds},
+		{format: "openmetrics", want: seconds},
+	}
+
+	for _, test := range tests {
+		c, err := newPrometheusCodec(common.MustNewConfigFrom(map[string]interface{}{"format": test.format}))
+		if err != nil {
+			t.Fatalf("Could not create codec: %v", err)
+		}
+		msg := &testMessage{properties: map[string]string{contentTypeProperty: test.contentType}}
+		events, err := c.decode(msg, payload)
+		if err != nil {
+			t.Errorf("%+v: unexpected error: %v", test, err)
+			continue
+		}
+		if len(events) != 1 || !events[0].Timestamp.Equal(test.want) {
+			t.Errorf("%+v: expected an event at %v, got %v", test, test.want, events)
+		}
+	}
+
+	if _, err := newPrometheusCodec(common.MustNewConfigFrom(map[string]interface{}{"format": "json"})); err == nil {
+		t.Error("Expected an error for an unknown format")
+	}
+}
+
+func TestPrometheusCodecGroupBy(t *testing.T) {
+	payload := []byte(`# HELP up Whether the target is up.
+# TYPE up gauge
+up{job="pulsar"} 1
+up{job="bookie"} 0 1395066363000
+`)
+	publishTime := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	msg := &testMessage{publishTime: publishTime}
+	family := common.MapStr{"name": "up", "type": "gauge", "help": "Whether the target is up."}
+
+	c, err := newPrometheusCodec(common.NewConfig())
+	if err != nil {
+		t.Fatalf("Could not create codec: %v", err)
+	}
+	events, err := c.decode(msg, payload)
+	if err != nil {
+		t.Fatalf("Could not decode: %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("Expected an event per sample, got %d", len(events))
+	}
+	want := common.MapStr{"prometheus": common.MapStr{
+		"family":  family,
+		"metrics": common.MapStr{"up": 1.0},
+		"labels":  common.MapStr{"job": "pulsar"},
+	}}
+	if !reflect.DeepEqual(events[0].Fields, want) {
+		t.Errorf("Expected fields\n%v\ngot\n%v", want.StringToPrint(), events[0].Fields.StringToPrint())
+	}
+	if !events[0].Timestamp.Equal(publishTime) {
+		t.Errorf("Expected the publish time for samples without timestamp, got %v", events[0].Timestamp)
+	}
+	if want := time.Unix(1395066363, 0); !events[1].Timestamp.Equal(want) {
+		t.Errorf("Expected the sample timestamp %v, got %v", want, events[1].Timestamp)
+	}
+
+	c, err = newPrometheusCodec(common.MustNewConfigFrom(map[string]interface{}{"group_by": "family"}))
+	if err != nil {
+		t.Fatalf("Could not create codec: %v", err)
+	}
+	events, err = c.decode(msg, payload)
+	if err != nil {
+		t.Fatalf("Could not decode: %v", err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("Expected an event per family, got %d", len(events))
+	}
+	want = common.MapStr{"prometheus": common.MapStr{
+		"family": family,
+		"samples": []common.MapStr{
+			{"name": "up", "value": 1.0, "labels": common.MapStr{"job": "pulsar"}},
+			{"name": "up", "value": 0.0, "labels": common.MapStr{"job": "bookie"}, "timestamp": time.Unix(1395066363, 0).UTC()},
+		},
+	}}
+	if !reflect.DeepEqual(events[0].Fields, want) {
+		t.Errorf("Expected fields\n%v\ngot\n%v", want.StringToPrint(), events[0].Fields.StringToPrint())
+	}
+	if !events[0].Timestamp.Equal(publishTime) {
+		t.Errorf("Expected the publish time, got %v", events[0].Timestamp)
+	}
+}
@@ -3,11 +3,13 @@ package beater
 import (
 	"context"
 	"fmt"
-	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/cfgfile"
 	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/instrumentation"
 	"github.com/elastic/beats/v7/libbeat/logp"
 	"github.com/yukshimizu/pulsarbeat/config"
+	"go.elastic.co/apm"
 	"sync"
 	"time"
 )
@@ -16,9 +18,12 @@ import (
 type pulsarbeat struct {
 	done            chan struct{}
 	config          config.Config
-	client          beat.Client
-	pulsarClient    *pulsar.Client
-	pulsarConsumers *[]pulsar.Consumer
+	clusters        map[string]*cluster
+	input           *input
+	instrumentation instrumentation.Instrumentation
+	output          common.ConfigNamespace
+	workers         workerRegistry
+	progress        *catchUp
 }
 
 const selector string = "pulsarbeat"
@@ -32,23 +37,50 @@ func New(b *beat.Beat, cfg *common.Config) (beat.Beater, error) {
 	}
 	logp.Debug(selector, "After reading config yml is: %#v", c)
 
-	client, err := config.NewPulsarClient(c.Client)
-	if err != nil {
-		return nil, fmt.Errorf("error creating pulsar client: %v", err)
+	switch c.RunMode {
+	case config.RunModeContinuous:
+	case config.RunModeUntilCaughtUp:
+		if c.Inputs != nil && c.Inputs.Enabled() {
+			return nil, fmt.Errorf("run_mode %s can not be combined with config.inputs", c.RunMode)
+		}
+	default:
+		return nil, fmt.Errorf("unknown run_mode: %s", c.RunMode)
 	}
 
-	consumers, err := config.NewPulsarConsumer(client, c.Consumer)
+	clusters, err := newClusters(c)
 	if err != nil {
-		return nil, fmt.Errorf("error creating pulsar consumer: %v", err)
+		return nil, err
 	}
 
 	bt := &pulsarbeat{
 		done:            make(chan struct{}),
 		config:          c,
-		pulsarClient:    client,
-		pulsarConsumers: consumers,
+		clusters:        clusters,
+		instrumentation: b.Instrumentation,
+	}
+	if b.Config != nil {
+		bt.output = b.Config.Output
 	}
 
+	// without the consumer section, only the inputs of config.inputs consume
+	if c.Consumer.Enabled {
+		bt.input, err = newInput(bt, b.Publisher, c)
+		if err != nil {
+			return nil, err
+		}
+		if err := bt.input.subscribe(); err != nil {
+			return nil, err
+		}
+		if c.RunMode == config.RunModeUntilCaughtUp {
+			bt.progress, err = newCatchUp(*bt.input.consumers, c.MaxMessages)
+			if err != nil {
+				return nil, fmt.Errorf("error looking up last messages: %v", err)
+			}
+			bt.input.progress = bt.progress
+		}
+	}
+	running.set(bt)
+
 	return bt, nil
 }
 
@@ -56,85 +88,132 @@ func New(b *beat.Beat, cfg *common.Config) (beat.Beater, error) {
 func (bt *pulsarbeat) Run(b *beat.Beat) error {
 	logp.Info("pulsarbeat is running! Hit CTRL-C to stop it.")
 
-	var err error
-	bt.client, err = b.Publisher.Connect()
-	if err != nil {
-		return err
+	defer closeClusters(bt.clusters)
+
+	if bt.input != nil {
+		if err := bt.input.launch(); err != nil {
+			return err
+		}
+		defer bt.input.Stop()
 	}
 
-	var wg sync.WaitGroup
+	// the failover supervisors and credentials watchers are stopped before the
+	// inputs and clients
 	ctx, cancel := context.WithCancel(context.Background())
+	var supervisors sync.WaitGroup
+	defer supervisors.Wait()
+	defer cancel()
+	for _, c := range bt.clusters {
+		if c.failover != nil {
+			supervisors.Add(1)
+			go func(f *failover) {
+				defer supervisors.Done()
+				f.run(ctx)
+			}(c.failover)
+		}
+		if c.credentials != nil {
+			supervisors.Add(1)
+			go func(w *credentialsWatcher) {
+				defer supervisors.Done()
+				w.run(ctx)
+			}(c.credentials)
+		}
+	}
 
-	defer func() {
-		(*bt.pulsarClient).Close()
-		logp.Debug(selector, "pulsar client Closed!")
-	}()
-
-	for _, consumer := range *bt.pulsarConsumers {
-		wg.Add(1)
-		consumer := consumer
-		go func(ctx context.Context) {
-			defer wg.Done()
-			defer func() {
-				consumer.Close()
-				logp.Debug(selector, "pulsar consumer: %#v Closed!", consumer)
-			}()
-
-			for {
-				select {
-				case <-ctx.Done():
-					logp.Debug(selector, "done ctx")
-					return
-				default:
-					// pulsar normal implementation
-					msg, err := consumer.Receive(ctx)
-					if err != nil {
-						logp.Debug(selector, "consumer Receive failed: %v", err)
-						if err != context.Canceled {
-							consumer.Nack(msg)
-						}
-					} else {
-
-						logp.Debug(selector, "Received message msgId: %#v -- content: '%s'",
-							msg.ID(), string(msg.Payload()))
-
-						event := beat.Event{
-							Timestamp: time.Now(),
-							Fields: common.MapStr{
-								"pulsar": common.MapStr{
-									"topic":     msg.Topic(),
-									"producer":  msg.ProducerName(),
-									"key":       msg.Key(),
-									"timestamp": msg.PublishTime(),
-								},
-								"message": string(msg.Payload()),
-							},
-						}
-
-						bt.client.Publish(event)
-						consumer.Ack(msg)
-
-						logp.Debug(selector, "Event sent")
-					}
-				}
-			}
-		}(ctx)
+	if bt.progress != nil {
+		bt.runUntilCaughtUp()
+		return nil
 	}
 
-	go func() {
-		<-bt.done
-		logp.Debug(selector, "calling cancel function")
-		cancel()
-	}()
+	if bt.config.Inputs != nil && bt.config.Inputs.Enabled() {
+		factory := &inputFactory{bt: bt}
+		reloader := cfgfile.NewReloader(b.Publisher, bt.config.Inputs)
+		if err := reloader.Check(factory); err != nil {
+			return err
+		}
+		go reloader.Run(factory)
+		// stopping the reloader stops the inputs it started
+		defer reloader.Stop()
+	}
 
-	wg.Wait()
+	<-bt.done
 	logp.Debug(selector, "Run method exited!")
 	return nil
 }
 
+// runUntilCaughtUp waits until the messages published before the start have
+// been received, or a limit is reached, and then for the output to acknowledge
+// the received messages. Partitions whose subscription is already past the
+// last message never deliver it, so the input is also caught up when no
+// message was received within the idle timeout.
+func (bt *pulsarbeat) runUntilCaughtUp() {
+	var deadline <-chan time.Time
+	if bt.config.MaxDuration > 0 {
+		deadline = time.After(bt.config.MaxDuration)
+	}
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+wait:
+	for {
+		select {
+		case <-bt.done:
+			return
+		case <-bt.progress.done:
+			logp.Info("Caught up with all topics")
+			break wait
+		case <-deadline:
+			logp.Info("Stopping after max_duration of %v", bt.config.MaxDuration)
+			break wait
+		case <-ticker.C:
+			if bt.config.IdleTimeout > 0 && time.Since(bt.progress.idleSince()) >= bt.config.IdleTimeout {
+				logp.Info("No message received within idle_timeout of %v, assuming caught up", bt.config.IdleTimeout)
+				break wait
+			}
+		}
+	}
+
+	workers := bt.workers.list()
+	for _, w := range workers {
+		w.pause()
+	}
+	for !acknowledged(workers) {
+		select {
+		case <-bt.done:
+			return
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+
+	topics, counts, total := bt.progress.summary()
+	for _, topic := range topics {
+		logp.Info("Processed %d messages of %s", counts[topic], topic)
+	}
+	logp.Info("Processed %d messages in total", total)
+}
+
+// tracer returns the tracer of the beat's instrumentation, or nil if
+// instrumentation is not enabled.
+func (bt *pulsarbeat) tracer() *apm.Tracer {
+	if bt.instrumentation == nil || !bt.instrumentation.Tracer().Active() {
+		return nil
+	}
+	return bt.instrumentation.Tracer()
+}
+
+// acknowledged reports whether the output acknowledged all messages received
+// by workers.
+func acknowledged(workers []*worker) bool {
+	for _, w := range workers {
+		if w.pending() != 0 {
+			return false
+		}
+	}
+	return true
+}
+
 // Stop stops pulsarbeat.
 func (bt *pulsarbeat) Stop() {
 	logp.Debug(selector, "Stop method called")
-	bt.client.Close()
 	close(bt.done)
 }
@@ -0,0 +1,89 @@
+package beater
+
+import (
+	"context"
+	"github.com/yukshimizu/pulsarbeat/config"
+	"golang.org/x/time/rate"
+	"math"
+	"sync"
+)
+
+// rateLimiter throttles the receive loop of the workers of a consumer in
+// messages and bytes per second. Its limits can be changed at runtime.
+type rateLimiter struct {
+	mu       sync.Mutex
+	settings config.RateLimit
+	messages *rate.Limiter
+	bytes    *rate.Limiter
+}
+
+func newRateLimiter(settings config.RateLimit) *rateLimiter {
+	r := &rateLimiter{
+		messages: rate.NewLimiter(rate.Inf, 0),
+		bytes:    rate.NewLimiter(rate.Inf, 0),
+	}
+	r.update(settings)
+	return r
+}
+
+// update applies new limits. A rate of 0 disables the respective limit and a
+// burst of 0 defaults to one second worth of the rate.
+func (r *rateLimiter) update(settings config.RateLimit) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.settings = settings
+	setLimit(r.messages, settings.MessagesPerSecond, settings.MessagesBurst)
+	setLimit(r.bytes, settings.BytesPerSecond, settings.BytesBurst)
+}
+
+func (r *rateLimiter) current() config.RateLimit {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.settings
+}
+
+func setLimit(limiter *rate.Limiter, perSecond float64, burst int) {
+	if perSecond <= 0 {
+		limiter.SetLimit(rate.Inf)
+		return
+	}
+	if burst <= 0 {
+		burst = int(math.Max(1, math.Ceil(perSecond)))
+	}
+	limiter.SetBurst(burst)
+	limiter.SetLimit(rate.Limit(perSecond))
+}
+
+// wait blocks until a message of size bytes may be processed. It only fails
+// if ctx is done.
+func (r *rateLimiter) wait(ctx context.Context, size int) error {
+	if err := retryWait(ctx, func() error { return r.messages.Wait(ctx) }); err != nil {
+		return err
+	}
+
+	// messages larger than the burst are let through once the bucket is full.
+	// The burst is read again on every attempt, as it may be lowered by an
+	// update between reading it and waiting.
+	return retryWait(ctx, func() error {
+		n := size
+		if r.bytes.Limit() != rate.Inf {
+			if burst := r.bytes.Burst(); n > burst {
+				n = burst
+			}
+		}
+		return r.bytes.WaitN(ctx, n)
+	})
+}
+
+// retryWait calls wait until it succeeds or ctx is done. The limiters reject a
+// wait exceeding their burst, which only happens if the limits changed
+// concurrently.
+func retryWait(ctx context.Context, wait func() error) error {
+	for {
+		err := wait()
+		if err == nil || ctx.Err() != nil {
+			return err
+		}
+	}
+}
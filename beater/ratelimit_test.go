@@ -0,0 +1,52 @@
+// +build !integration
+
+package beater
+
+import (
+	"context"
+	"github.com/yukshimizu/pulsarbeat/config"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterWaitWhileBurstLowered(t *testing.T) {
+	r := newRateLimiter(config.RateLimit{BytesPerSecond: 1e9, BytesBurst: 1e6})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for burst := 1e6; ; burst = 1e6 - burst + 1 {
+			select {
+			case <-stop:
+				return
+			default:
+				r.update(config.RateLimit{BytesPerSecond: 1e9, BytesBurst: int(burst)})
+			}
+		}
+	}()
+	defer wg.Wait()
+	defer close(stop)
+
+	for i := 0; i < 1000; i++ {
+		if err := r.wait(ctx, 1e6); err != nil {
+			t.Fatalf("Expected wait to succeed while the burst changes, got %v", err)
+		}
+	}
+}
+
+func TestRateLimiterWaitCancelled(t *testing.T) {
+	r := newRateLimiter(config.RateLimit{MessagesPerSecond: 0.001, MessagesBurst: 1})
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := r.wait(ctx, 1); err != nil {
+		t.Fatalf("Expected the first message within the burst, got %v", err)
+	}
+	cancel()
+	if err := r.wait(ctx, 1); err == nil {
+		t.Error("Expected a cancelled wait to fail")
+	}
+}
@@ -0,0 +1,133 @@
+package beater
+
+import (
+	"context"
+	"fmt"
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/elastic/beats/v7/libbeat/monitoring"
+	"github.com/yukshimizu/pulsarbeat/config"
+	"hash/fnv"
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+)
+
+var sampledOutMessages = monitoring.NewInt(metricsRegistry, "sampled_out_messages")
+
+// sampler keeps a representative sample of the received messages, either
+// each message with a fixed probability, all messages of a sampled key, or a
+// fixed number of messages per second (reservoir).
+type sampler struct {
+	mode        string
+	probability float64
+	reservoir   *reservoir
+}
+
+// newSampler returns nil if sampling is not configured.
+func newSampler(mode string, probability float64, reservoirSize int) (*sampler, error) {
+	switch mode {
+	case "":
+		return nil, nil
+	case config.SamplingProbability, config.SamplingKeyHash:
+		if probability <= 0 || probability > 1 {
+			return nil, fmt.Errorf("sampling probability must be within (0, 1], got %v", probability)
+		}
+		return &sampler{mode: mode, probability: probability}, nil
+	case config.SamplingReservoir:
+		if reservoirSize <= 0 {
+			return nil, fmt.Errorf("reservoir sampling requires a positive reservoir_size")
+		}
+		return &sampler{mode: mode, reservoir: newReservoir(reservoirSize)}, nil
+	default:
+		return nil, fmt.Errorf("unknown sampling mode: %s", mode)
+	}
+}
+
+// sample decides whether msg is kept, in which case it represents
+// 1/probability messages. Messages without a key are sampled by probability in
+// key_hash mode. Not used in reservoir mode.
+func (s *sampler) sample(msg pulsar.Message) bool {
+	if key := msg.Key(); s.mode == config.SamplingKeyHash && key != "" {
+		h := fnv.New32a()
+		h.Write([]byte(key))
+		return float64(h.Sum32()) < s.probability*(math.MaxUint32+1)
+	}
+	return rand.Float64() < s.probability
+}
+
+// sampledMessage is a message held by the reservoir.
+type sampledMessage struct {
+	worker   *worker
+	msg      pulsar.Message
+	received time.Time
+	seq      int // position among the messages offered within the second
+}
+
+// reservoir keeps a uniform sample of at most size messages out of the
+// messages received within a second (algorithm R). Messages replaced in the
+// reservoir are returned to be acknowledged right away.
+type reservoir struct {
+	mu   sync.Mutex
+	size int
+	seen int
+	kept []sampledMessage
+}
+
+func newReservoir(size int) *reservoir {
+	return &reservoir{size: size, kept: make([]sampledMessage, 0, size)}
+}
+
+// offer adds m to the current sample. It returns the message which is not part
+// of the sample anymore, if any.
+func (r *reservoir) offer(m sampledMessage) (sampledMessage, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.seen++
+	m.seq = r.seen
+	if len(r.kept) < r.size {
+		r.kept = append(r.kept, m)
+		return sampledMessage{}, false
+	}
+	if j := rand.Intn(r.seen); j < r.size {
+		m, r.kept[j] = r.kept[j], m
+	}
+	return m, true
+}
+
+// take returns the sample of the elapsed second in the order the messages were
+// received, with its sample rate, and starts a new one.
+func (r *reservoir) take() ([]sampledMessage, float64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	kept, seen := r.kept, r.seen
+	r.kept = make([]sampledMessage, 0, r.size)
+	r.seen = 0
+	if seen == 0 {
+		return nil, 0
+	}
+	sort.Slice(kept, func(i, j int) bool { return kept[i].seq < kept[j].seq })
+	return kept, float64(len(kept)) / float64(seen)
+}
+
+// run hands the sample of every second to process until ctx is cancelled.
+// Messages still held then are left unacknowledged for redelivery.
+func (r *reservoir) run(ctx context.Context, process func(m sampledMessage, sampleRate float64)) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			kept, sampleRate := r.take()
+			for _, m := range kept {
+				process(m, sampleRate)
+			}
+		}
+	}
+}
@@ -0,0 +1,154 @@
+// +build !integration
+
+package beater
+
+import (
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/yukshimizu/pulsarbeat/config"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestNewSampler(t *testing.T) {
+	tests := []struct {
+		mode          string
+		probability   float64
+		reservoirSize int
+		wantErr       bool
+		wantNil       bool
+	}{
+		{mode: "", wantNil: true},
+		{mode: config.SamplingProbability, probability: 0.1},
+		{mode: config.SamplingProbability, probability: 1},
+		{mode: config.SamplingProbability, probability: 0, wantErr: true},
+		{mode: config.SamplingProbability, probability: 1.5, wantErr: true},
+		{mode: config.SamplingKeyHash, probability: 0.5},
+		{mode: config.SamplingKeyHash, wantErr: true},
+		{mode: config.SamplingReservoir, reservoirSize: 10},
+		{mode: config.SamplingReservoir, wantErr: true},
+		{mode: "random", probability: 0.5, wantErr: true},
+	}
+
+	for _, test := range tests {
+		s, err := newSampler(test.mode, test.probability, test.reservoirSize)
+		if (err != nil) != test.wantErr {
+			t.Errorf("%+v: unexpected error: %v", test, err)
+			continue
+		}
+		if !test.wantErr && (s == nil) != test.wantNil {
+			t.Errorf("%+v: expected nil sampler %v, got %v", test, test.wantNil, s)
+		}
+	}
+}
+
+func TestSamplerKeyHash(t *testing.T) {
+	s, err := newSampler(config.SamplingKeyHash, 0.5, 0)
+	if err != nil {
+		t.Fatalf("Could not create sampler: %v", err)
+	}
+
+	kept := 0
+	for i := 0; i < 1000; i++ {
+		msg := &testMessage{key: "key-" + strconv.Itoa(i)}
+		keep := s.sample(msg)
+		for j := 0; j < 5; j++ {
+			if s.sample(msg) != keep {
+				t.Fatalf("Expected all messages of %s to be sampled alike", msg.key)
+			}
+		}
+		if keep {
+			kept++
+		}
+	}
+	if kept < 400 || kept > 600 {
+		t.Errorf("Expected about half of the keys to be kept, got %d of 1000", kept)
+	}
+
+	all, _ := newSampler(config.SamplingKeyHash, 1, 0)
+	for i := 0; i < 100; i++ {
+		if !all.sample(&testMessage{key: "key-" + strconv.Itoa(i)}) {
+			t.Fatal("Expected probability 1 to keep all keys")
+		}
+	}
+}
+
+func TestReservoir(t *testing.T) {
+	r := newReservoir(3)
+	replaced := 0
+	for i := 0; i < 10; i++ {
+		if _, ok := r.offer(sampledMessage{msg: &testMessage{payload: []byte(strconv.Itoa(i))}}); ok {
+			replaced++
+		}
+	}
+	if replaced != 7 {
+		t.Errorf("Expected 7 messages to leave the sample, got %d", replaced)
+	}
+
+	kept, sampleRate := r.take()
+	if len(kept) != 3 {
+		t.Fatalf("Expected 3 kept messages, got %d", len(kept))
+	}
+	if sampleRate != 0.3 {
+		t.Errorf("Expected sample rate 0.3, got %v", sampleRate)
+	}
+	if !sort.SliceIsSorted(kept, func(i, j int) bool { return kept[i].seq < kept[j].seq }) {
+		t.Errorf("Expected the sample in receive order, got %+v", kept)
+	}
+
+	if kept, sampleRate := r.take(); kept != nil || sampleRate != 0 {
+		t.Errorf("Expected an empty sample, got %d messages at rate %v", len(kept), sampleRate)
+	}
+}
+
+func TestReservoirForwardsThroughRateLimit(t *testing.T) {
+	c := config.DefaultConfig
+	c.Consumer.Topic = "my-topic"
+	c.Consumer.PublishWorkers = 4
+	c.Consumer.Ordering = config.OrderingPerKey
+	c.Consumer.Sampling.Mode = config.SamplingReservoir
+	c.Consumer.Sampling.ReservoirSize = 100
+	c.Consumer.RateLimit = config.RateLimit{MessagesPerSecond: 0.1, MessagesBurst: 2}
+	in, err := testInput(t, c)
+	if err != nil {
+		t.Fatalf("Could not create input: %v", err)
+	}
+	client := &testClient{}
+	in.pipeline = testPipeline{client: client}
+
+	var messages []pulsar.Message
+	for _, payload := range []string{"a/0", "b/0", "a/1", "b/1", "a/2"} {
+		messages = append(messages, &testMessage{topic: "my-topic", key: payload[:1], payload: []byte(payload)})
+	}
+	consumer := newTestConsumer(messages...)
+	in.consumers = &[]pulsar.Consumer{consumer}
+
+	if err := in.start(); err != nil {
+		t.Fatalf("Could not start input: %v", err)
+	}
+	waitFor(t, "the sample to be published", func() bool {
+		return len(client.events()) == 2
+	})
+	// the rest of the sample waits for the rate limit
+	time.Sleep(50 * time.Millisecond)
+	workers := in.workers
+	in.stop()
+
+	published := map[string]bool{}
+	for _, event := range client.events() {
+		message, _ := event.Fields.GetValue("message")
+		published[message.(string)] = true
+	}
+	if len(published) != 2 || !published["a/0"] || !published["b/0"] {
+		t.Errorf("Expected the first message of each key to be published, got %v", published)
+	}
+	if n := len(consumer.ackedMessages()); n != 2 {
+		t.Errorf("Expected 2 acknowledged messages, got %d", n)
+	}
+	for _, w := range workers {
+		if n := w.pending(); n != 0 {
+			t.Errorf("Expected the throttled messages to be released, got %d pending", n)
+		}
+	}
+}
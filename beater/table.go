@@ -0,0 +1,50 @@
+package beater
+
+import (
+	"fmt"
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"strings"
+)
+
+// toTableEvent turns event into an upsert of the document identified by the
+// message key, or into a delete of that document if the message is a tombstone
+// (empty payload). Messages without a key can not be mapped to a document and
+// are dropped before.
+func toTableEvent(event *beat.Event, msg pulsar.Message) {
+	opType := "index"
+	if len(msg.Payload()) == 0 {
+		opType = "delete"
+	}
+	if event.Meta == nil {
+		event.Meta = common.MapStr{}
+	}
+	event.Meta["_id"] = msg.Key()
+	event.Meta["op_type"] = opType
+}
+
+// tableOutputValidate checks that the elasticsearch output writes the
+// documents of a table into a single fixed index. Documents upserted through a
+// rollover alias or into daily indices would leave their older versions in the
+// previous indices, and deletes would only reach the current one. The ILM
+// setup is not visible to the beater, so it is only documented that ILM must
+// be disabled, as it replaces the index by its rollover alias.
+func tableOutputValidate(output common.ConfigNamespace) error {
+	if !output.IsSet() || output.Name() != "elasticsearch" {
+		return nil
+	}
+	var settings struct {
+		Index string `config:"index"`
+	}
+	if err := output.Config().Unpack(&settings); err != nil {
+		return fmt.Errorf("error reading output settings: %v", err)
+	}
+	if settings.Index == "" {
+		return fmt.Errorf("table mode requires a fixed output.elasticsearch.index")
+	}
+	if strings.Contains(settings.Index, "%{+") {
+		return fmt.Errorf("table mode requires a fixed output.elasticsearch.index, got the dated index %s", settings.Index)
+	}
+	return nil
+}
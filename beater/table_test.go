@@ -0,0 +1,89 @@
+// +build !integration
+
+package beater
+
+import (
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"reflect"
+	"testing"
+)
+
+func TestToTableEvent(t *testing.T) {
+	tests := []struct {
+		name     string
+		meta     common.MapStr
+		payload  string
+		wantMeta common.MapStr
+	}{
+		{
+			name:     "Upsert",
+			payload:  `{"state":"on"}`,
+			wantMeta: common.MapStr{"_id": "device-1", "op_type": "index"},
+		},
+		{
+			name:     "Tombstone",
+			payload:  "",
+			wantMeta: common.MapStr{"_id": "device-1", "op_type": "delete"},
+		},
+		{
+			name:     "Keeps metadata",
+			meta:     common.MapStr{"pipeline": "devices"},
+			payload:  `{"state":"off"}`,
+			wantMeta: common.MapStr{"_id": "device-1", "op_type": "index", "pipeline": "devices"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			event := beat.Event{Meta: test.meta, Fields: common.MapStr{}}
+			toTableEvent(&event, &testMessage{key: "device-1", payload: []byte(test.payload)})
+			if !reflect.DeepEqual(event.Meta, test.wantMeta) {
+				t.Errorf("Expected metadata %v, got %v", test.wantMeta, event.Meta)
+			}
+		})
+	}
+}
+
+func TestTableOutputValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		output  map[string]interface{}
+		wantErr bool
+	}{
+		{name: "No output"},
+		{
+			name:   "Other output",
+			output: map[string]interface{}{"console": map[string]interface{}{"pretty": true}},
+		},
+		{
+			name:   "Fixed index",
+			output: map[string]interface{}{"elasticsearch": map[string]interface{}{"index": "devices"}},
+		},
+		{
+			name:    "Default index",
+			output:  map[string]interface{}{"elasticsearch": map[string]interface{}{"hosts": []string{"localhost:9200"}}},
+			wantErr: true,
+		},
+		{
+			name:    "Dated index",
+			output:  map[string]interface{}{"elasticsearch": map[string]interface{}{"index": "devices-%{+yyyy.MM.dd}"}},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var output common.ConfigNamespace
+			if test.output != nil {
+				if err := common.MustNewConfigFrom(test.output).Unpack(&output); err != nil {
+					t.Fatalf("Could not read output: %v", err)
+				}
+			}
+			err := tableOutputValidate(output)
+			if (err != nil) != test.wantErr {
+				t.Errorf("Expected error %v, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
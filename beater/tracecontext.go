@@ -0,0 +1,73 @@
+package beater
+
+import (
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"go.elastic.co/apm"
+	"go.elastic.co/apm/module/apmhttp"
+	"time"
+)
+
+const (
+	traceparentProperty = "traceparent"
+	tracestateProperty  = "tracestate"
+)
+
+// messageTraceContext returns the W3C trace context producers propagate in the
+// traceparent and tracestate properties of msg. An invalid tracestate is
+// ignored, as it only carries vendor specific data.
+func messageTraceContext(msg pulsar.Message) (apm.TraceContext, bool) {
+	traceparent, ok := msg.Properties()[traceparentProperty]
+	if !ok {
+		return apm.TraceContext{}, false
+	}
+	tc, err := apmhttp.ParseTraceparentHeader(traceparent)
+	if err != nil {
+		return apm.TraceContext{}, false
+	}
+	if tracestate, ok := msg.Properties()[tracestateProperty]; ok {
+		if state, err := apmhttp.ParseTracestateHeader(tracestate); err == nil {
+			tc.State = state
+		}
+	}
+	return tc, true
+}
+
+// startTransaction starts the transaction measuring the time from receiving
+// msg until the output acknowledged its events. It continues the trace of the
+// producer, if any.
+func startTransaction(tracer *apm.Tracer, msg pulsar.Message, received time.Time) *apm.Transaction {
+	tc, _ := messageTraceContext(msg)
+	tx := tracer.StartTransactionOptions("consume "+msg.Topic(), "messaging", apm.TransactionOptions{
+		TraceContext: tc,
+		Start:        received,
+	})
+	tx.Context.SetLabel("pulsar_topic", msg.Topic())
+	return tx
+}
+
+// putTraceContext adds the trace context of msg to fields, unless the codec
+// already decoded one from the payload. The event belongs to the transaction
+// consuming msg if there is one, and to the span producing msg otherwise.
+func putTraceContext(fields common.MapStr, msg pulsar.Message, tx *apm.Transaction) {
+	if ok, _ := fields.HasKey("trace.id"); ok {
+		return
+	}
+
+	producer, ok := messageTraceContext(msg)
+	if tx == nil {
+		if ok {
+			fields.Put("trace.id", producer.Trace.String())
+			fields.Put("span.id", producer.Span.String())
+		}
+		return
+	}
+
+	consumer := tx.TraceContext()
+	fields.Put("trace.id", consumer.Trace.String())
+	fields.Put("transaction.id", consumer.Span.String())
+	fields.Put("span.id", consumer.Span.String())
+	if ok {
+		fields.Put("parent.id", producer.Span.String())
+	}
+}
@@ -0,0 +1,141 @@
+// +build !integration
+
+package beater
+
+import (
+	"github.com/elastic/beats/v7/libbeat/common"
+	"go.elastic.co/apm/apmtest"
+	"testing"
+	"time"
+)
+
+const (
+	testTraceID     = "0af7651916cd43dd8448eb211c80319c"
+	testSpanID      = "b7ad6b7169203331"
+	testTraceparent = "00-" + testTraceID + "-" + testSpanID + "-01"
+)
+
+func TestMessageTraceContext(t *testing.T) {
+	tests := []struct {
+		name       string
+		properties map[string]string
+		wantOK     bool
+		wantState  string
+	}{
+		{name: "Missing traceparent", properties: map[string]string{"tracestate": "vendor=value"}},
+		{name: "Malformed traceparent", properties: map[string]string{"traceparent": "00-not-a-trace-context"}},
+		{name: "Traceparent", properties: map[string]string{"traceparent": testTraceparent}, wantOK: true},
+		{
+			name:       "Tracestate",
+			properties: map[string]string{"traceparent": testTraceparent, "tracestate": "vendor=value,other=1"},
+			wantOK:     true,
+			wantState:  "vendor=value,other=1",
+		},
+		{
+			name:       "Invalid tracestate is ignored",
+			properties: map[string]string{"traceparent": testTraceparent, "tracestate": "no equal sign"},
+			wantOK:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tc, ok := messageTraceContext(&testMessage{properties: test.properties})
+			if ok != test.wantOK {
+				t.Fatalf("Expected a trace context %v, got %v", test.wantOK, ok)
+			}
+			if !ok {
+				return
+			}
+			if tc.Trace.String() != testTraceID || tc.Span.String() != testSpanID {
+				t.Errorf("Expected trace %s and span %s, got %s and %s", testTraceID, testSpanID, tc.Trace, tc.Span)
+			}
+			if state := tc.State.String(); state != test.wantState {
+				t.Errorf("Expected tracestate %q, got %q", test.wantState, state)
+			}
+		})
+	}
+}
+
+func TestPutTraceContext(t *testing.T) {
+	tests := []struct {
+		name        string
+		properties  map[string]string
+		fields      common.MapStr
+		transaction bool
+		want        func(txTrace, txID string) common.MapStr
+	}{
+		{
+			name:       "Without trace context",
+			properties: map[string]string{},
+			want:       func(string, string) common.MapStr { return common.MapStr{} },
+		},
+		{
+			name:       "Producer span",
+			properties: map[string]string{"traceparent": testTraceparent},
+			want: func(string, string) common.MapStr {
+				return common.MapStr{"trace": common.MapStr{"id": testTraceID}, "span": common.MapStr{"id": testSpanID}}
+			},
+		},
+		{
+			name:       "Trace decoded by the codec",
+			properties: map[string]string{"traceparent": testTraceparent},
+			fields:     common.MapStr{"trace": common.MapStr{"id": "decoded"}},
+			want: func(string, string) common.MapStr {
+				return common.MapStr{"trace": common.MapStr{"id": "decoded"}}
+			},
+		},
+		{
+			name:        "Transaction continuing the producer trace",
+			properties:  map[string]string{"traceparent": testTraceparent},
+			transaction: true,
+			want: func(txTrace, txID string) common.MapStr {
+				return common.MapStr{
+					"trace":       common.MapStr{"id": txTrace},
+					"transaction": common.MapStr{"id": txID},
+					"span":        common.MapStr{"id": txID},
+					"parent":      common.MapStr{"id": testSpanID},
+				}
+			},
+		},
+		{
+			name:        "Transaction without producer trace",
+			properties:  map[string]string{},
+			transaction: true,
+			want: func(txTrace, txID string) common.MapStr {
+				return common.MapStr{
+					"trace":       common.MapStr{"id": txTrace},
+					"transaction": common.MapStr{"id": txID},
+					"span":        common.MapStr{"id": txID},
+				}
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			msg := &testMessage{topic: "my-topic", properties: test.properties}
+			fields := test.fields
+			if fields == nil {
+				fields = common.MapStr{}
+			}
+
+			var txTrace, txID string
+			if test.transaction {
+				tx := startTransaction(apmtest.DiscardTracer, msg, time.Now())
+				defer tx.End()
+				txTrace, txID = tx.TraceContext().Trace.String(), tx.TraceContext().Span.String()
+				if _, ok := test.properties["traceparent"]; ok && txTrace != testTraceID {
+					t.Errorf("Expected the transaction to continue trace %s, got %s", testTraceID, txTrace)
+				}
+				putTraceContext(fields, msg, tx)
+			} else {
+				putTraceContext(fields, msg, nil)
+			}
+
+			if want := test.want(txTrace, txID); fields.String() != want.String() {
+				t.Errorf("Expected fields %v, got %v", want, fields)
+			}
+		})
+	}
+}
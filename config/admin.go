@@ -0,0 +1,175 @@
+package config
+
+import (
+	"github.com/apache/pulsar-client-go/pulsaradmin"
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/pkg/errors"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// NewPulsarAdmin creates a client of the admin API served by the web service
+// of the cluster. The admin_url setting defaults to the service URL if it is a
+// web service URL. Without either, nil is returned as the admin API is only
+// needed by some features.
+func NewPulsarAdmin(clientOptions pulsarClientOptions) (pulsaradmin.Client, error) {
+	adminURL := clientOptions.AdminURL
+	if adminURL == "" && (strings.HasPrefix(clientOptions.URL, "http://") ||
+		strings.HasPrefix(clientOptions.URL, "https://")) {
+		adminURL = clientOptions.URL
+	}
+	if adminURL == "" {
+		return nil, nil
+	}
+
+	adminConfig := &pulsaradmin.Config{
+		WebServiceURL:                 adminURL,
+		TLSTrustCertsFilePath:         strings.TrimPrefix(clientOptions.TLSTrustCertsFilePath, fileURIPrefix),
+		TLSAllowInsecureConnection:    clientOptions.TLSAllowInsecureConnection,
+		TLSEnableHostnameVerification: clientOptions.TLSValidateHostname,
+	}
+
+	auth, err := clientOptions.authValidate()
+	if err != nil {
+		return nil, errors.Wrap(err, "Invalid Authentication Settings")
+	}
+	switch auth {
+	case authProviderAthenz:
+		return nil, errors.New("Athenz authentication is not supported by the admin API client")
+	case authProviderTLS:
+		tlsOptions := clientOptions.AuthenticationTLS
+		if isPEM(tlsOptions.CertificatePath) || isPEM(tlsOptions.PrivateKeyPath) {
+			return nil, errors.New("TLS authentication with PEM content is not supported by the admin API client")
+		}
+		adminConfig.TLSCertFile = strings.TrimPrefix(tlsOptions.CertificatePath, fileURIPrefix)
+		adminConfig.TLSKeyFile = strings.TrimPrefix(tlsOptions.PrivateKeyPath, fileURIPrefix)
+	case authProviderToken:
+		if token := clientOptions.AuthenticationToken.Token; strings.HasPrefix(token, fileURIPrefix) {
+			adminConfig.TokenFile = strings.TrimPrefix(token, fileURIPrefix)
+		} else {
+			adminConfig.Token = token
+		}
+	case authProviderOAuth2:
+		params, err := clientOptions.AuthenticationOAuth2.params()
+		if err != nil {
+			return nil, errors.Wrap(err, "Loading Authentication Credentials")
+		}
+		adminConfig.IssuerEndpoint = params["issuerUrl"]
+		adminConfig.ClientID = params["clientId"]
+		adminConfig.Audience = params["audience"]
+		adminConfig.Scope = params["scope"]
+		adminConfig.KeyFile = params["privateKey"]
+	default:
+	}
+
+	admin, err := pulsaradmin.NewClient(adminConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "Initializing pulsar admin client")
+	}
+	return admin, nil
+}
+
+// subscriptionExists reports whether subscription has been created on topic.
+func subscriptionExists(admin pulsaradmin.Client, topic, subscription string) (bool, error) {
+	topicName, err := utils.GetTopicName(topic)
+	if err != nil {
+		return false, err
+	}
+	subscriptions, err := admin.Subscriptions().List(*topicName)
+	if err != nil {
+		return false, err
+	}
+	for _, s := range subscriptions {
+		if s == subscription {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// DiscoverTopics looks up the topics matching the topics pattern through the
+// admin API, sorted by name. The pattern is matched against the whole topic
+// name without the domain, e.g. public/default/logs-.*, and the domains are
+// selected by the topics pattern mode. With the namespace discovery the
+// pattern starts with the namespace to look up, with the cluster discovery
+// the namespaces of all tenants are looked up. Partitions are subscribed by
+// their partitioned topic.
+func DiscoverTopics(admin pulsaradmin.Client, consumerOptions pulsarConsumerOptions) ([]string, error) {
+	if admin == nil {
+		return nil, errors.New("Discovering topics requires the admin_url setting")
+	}
+	pattern := strings.TrimPrefix(consumerOptions.TopicsPattern, "persistent://")
+	pattern = strings.TrimPrefix(pattern, "non-persistent://")
+	regex, err := regexp.Compile("^(?:" + pattern + ")$")
+	if err != nil {
+		return nil, errors.Wrap(err, "Invalid topics pattern")
+	}
+
+	var namespaces []string
+	if consumerOptions.TopicsPatternDiscovery == topicsPatternDiscoveryCluster {
+		tenants, err := admin.Tenants().List()
+		if err != nil {
+			return nil, errors.Wrap(err, "Listing pulsar tenants")
+		}
+		for _, tenant := range tenants {
+			tenantNamespaces, err := admin.Namespaces().GetNamespaces(tenant)
+			if err != nil {
+				return nil, errors.Wrapf(err, "Listing namespaces of pulsar tenant %s", tenant)
+			}
+			namespaces = append(namespaces, tenantNamespaces...)
+		}
+	} else {
+		parts := strings.SplitN(pattern, "/", 3)
+		if len(parts) != 3 || regexp.QuoteMeta(parts[0]) != parts[0] || regexp.QuoteMeta(parts[1]) != parts[1] {
+			return nil, errors.Errorf("Topics pattern %s does not start with a namespace", consumerOptions.TopicsPattern)
+		}
+		namespaces = []string{parts[0] + "/" + parts[1]}
+	}
+
+	var topics []string
+	discovered := make(map[string]bool)
+	for _, namespace := range namespaces {
+		namespaceName, err := utils.GetNamespaceName(namespace)
+		if err != nil {
+			return nil, err
+		}
+		partitioned, nonPartitioned, err := admin.Topics().List(*namespaceName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Listing topics of pulsar namespace %s", namespace)
+		}
+		for _, topic := range append(partitioned, nonPartitioned...) {
+			topicName, err := utils.GetTopicName(topic)
+			if err != nil {
+				return nil, err
+			}
+			if !topicsPatternModeMatches(consumerOptions.TopicsPatternMode, topicName) {
+				continue
+			}
+			localName := topicName.GetLocalName()
+			if index := topicName.GetPartitionIndex(); index >= 0 {
+				localName = strings.TrimSuffix(localName, utils.PARTITIONEDTOPICSUFFIX+strconv.Itoa(index))
+			}
+			name := topicName.GetTenant() + "/" + topicName.GetNamespace() + "/" + localName
+			topic = topicName.GetDomain().String() + "://" + name
+			if !discovered[topic] && regex.MatchString(name) {
+				discovered[topic] = true
+				topics = append(topics, topic)
+			}
+		}
+	}
+	sort.Strings(topics)
+	return topics, nil
+}
+
+func topicsPatternModeMatches(mode string, topicName *utils.TopicName) bool {
+	switch mode {
+	case topicsPatternModeAll:
+		return true
+	case topicsPatternModeNonPersistent:
+		return !topicName.IsPersistent()
+	default:
+		return topicName.IsPersistent()
+	}
+}
@@ -5,26 +5,69 @@ package config
 
 import (
 	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/apache/pulsar-client-go/pulsaradmin"
+	"github.com/apache/pulsar-client-go/pulsaradmin/pkg/utils"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/cfgtype"
+	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/v7/libbeat/conditions"
+	"github.com/elastic/beats/v7/libbeat/logp"
 	"github.com/pkg/errors"
+	"math"
+	"regexp"
 	"strconv"
+	"strings"
 	"time"
 )
 
 type Config struct {
-	Client   pulsarClientOptions   `config:"client"`
-	Consumer pulsarConsumerOptions `config:"consumer"`
+	Client      pulsarClientOptions   `config:"client"`
+	Clients     []pulsarClientOptions `config:"clients"`
+	Consumer    pulsarConsumerOptions `config:"consumer"`
+	Inputs      *common.Config        `config:"config.inputs"`
+	API         apiOptions            `config:"api"`
+	RunMode     string                `config:"run_mode"`
+	MaxMessages int64                 `config:"max_messages" validate:"min=0"`
+	MaxDuration time.Duration         `config:"max_duration" validate:"min=0"`
+	IdleTimeout time.Duration         `config:"idle_timeout" validate:"min=0"`
+}
+
+type apiOptions struct {
+	AllowRemote bool `config:"allow_remote"`
 }
 
 type pulsarClientOptions struct {
-	URL                        string            `config:"url" validate:"required"`
-	ConnectionTimeout          time.Duration     `config:"connection_timeout" validate:"min=0"`
-	OperationTimeout           time.Duration     `config:"operation_timeout" validate:"min=0"`
-	AuthenticationTLS          authenticationTLS `config:"authentication_tls"`
-	AuthenticationAthenz       map[string]string `config:"authentication_athenz"`
-	TLSTrustCertsFilePath      string            `config:"tls_trust_certs_file_path"`
-	TLSAllowInsecureConnection bool              `config:"tls_allow_insecure_connection"`
-	TLSValidateHostname        bool              `config:"tls_validate_hostname"`
-	MaxConnectionsPerBroker    int               `config:"max_connections_per_broker"`
+	Name                       string                  `config:"name"`
+	URL                        string                  `config:"url" validate:"required"`
+	ConnectionTimeout          time.Duration           `config:"connection_timeout" validate:"min=0"`
+	OperationTimeout           time.Duration           `config:"operation_timeout" validate:"min=0"`
+	AuthenticationTLS          authenticationTLS       `config:"authentication_tls"`
+	AuthenticationAthenz       map[string]string       `config:"authentication_athenz"`
+	AuthenticationToken        authenticationToken     `config:"authentication_token"`
+	AuthenticationOAuth2       authenticationOAuth2    `config:"authentication_oauth2"`
+	CredentialsReloadInterval  time.Duration           `config:"credentials_reload_interval"`
+	TLSTrustCertsFilePath      string                  `config:"tls_trust_certs_file_path"`
+	TLSAllowInsecureConnection bool                    `config:"tls_allow_insecure_connection"`
+	TLSValidateHostname        bool                    `config:"tls_validate_hostname"`
+	TLSMinVersion              tlscommon.TLSVersion    `config:"tls_min_version"`
+	TLSMaxVersion              tlscommon.TLSVersion    `config:"tls_max_version"`
+	TLSCipherSuites            []tlscommon.CipherSuite `config:"tls_cipher_suites"`
+	MaxConnectionsPerBroker    int                     `config:"max_connections_per_broker"`
+	ListenerName               string                  `config:"listener_name"`
+	MemoryLimitBytes           int64                   `config:"memory_limit_bytes"`
+	ConnectionMaxIdleTime      time.Duration           `config:"connection_max_idle_time"`
+	KeepAliveInterval          time.Duration           `config:"keep_alive_interval" validate:"min=0"`
+	MetricsCardinality         string                  `config:"metrics_cardinality"`
+	MaxLookupRedirects         *int                    `config:"max_lookup_redirects"` // rejected, see clientValidate
+	AdminURL                   string                  `config:"admin_url"`
+	Failover                   failover                `config:"failover"`
+}
+
+// failover switches from url, the primary, to the first reachable secondary.
+type failover struct {
+	Secondaries     []string      `config:"secondaries"`
+	ProbeInterval   time.Duration `config:"probe_interval" validate:"min=0"`
+	SwitchBackDelay time.Duration `config:"switch_back_delay" validate:"min=0"`
 }
 
 type authenticationTLS struct {
@@ -32,47 +75,317 @@ type authenticationTLS struct {
 	PrivateKeyPath  string `config:"private_key_path"`
 }
 
+type authenticationToken struct {
+	Token string `config:"token"`
+}
+
+type authenticationOAuth2 struct {
+	IssuerURL    string `config:"issuer_url"`
+	Audience     string `config:"audience"`
+	Scope        string `config:"scope"`
+	ClientID     string `config:"client_id"`
+	ClientSecret string `config:"client_secret"`
+	PrivateKey   string `config:"private_key"`
+}
+
 type pulsarConsumerOptions struct {
+	Enabled                     bool              `config:"enabled"`
 	Topic                       string            `config:"topic"`
 	Topics                      []string          `config:"topics"`
 	TopicsPattern               string            `config:"topics_pattern"`
+	TopicsPatternMode           string            `config:"topics_pattern_mode"`
+	TopicsPatternDiscovery      string            `config:"topics_pattern_discovery"`
 	AutoDiscoveryPeriod         time.Duration     `config:"auto_discovery_period" validate:"min=0"`
 	SubscriptionName            string            `config:"subscription_name" validate:"required"`
+	Client                      string            `config:"client"`
 	Properties                  map[string]string `config:"properties"`
 	Type                        string            `config:"subscription_type"`
 	SubscriptionInitialPosition string            `config:"subscription_initial_position"`
 	ReceiverQueueSize           int               `config:"receiver_queue_size"`
 	NackRedeliveryDelay         time.Duration     `config:"nack_redelivery_delay" validate:"min=0"`
+	NackBackoff                 nackBackoff       `config:"nack_backoff"`
+	EnableBatchIndexAck         bool              `config:"enable_batch_index_acknowledgment"`
+	MaxPendingChunkedMessage    int               `config:"max_pending_chunked_message" validate:"min=0"`
+	ExpireTimeOfIncompleteChunk time.Duration     `config:"expire_time_of_incomplete_chunk" validate:"min=0"`
+	SubscriptionProperties      map[string]string `config:"subscription_properties"`
+	SubscriptionMode            string            `config:"subscription_mode"`
+	PriorityLevel               *int              `config:"priority_level"` // rejected, see Validate
 	Name                        string            `config:"name"`
 	ReadCompacted               bool              `config:"read_compacted"`
 	ReplicateSubscriptionState  bool              `config:"replicate_subscription_state"`
 	NumWorkers                  int               `config:"num_workers" validate:"min=1"`
+	Decryption                  decryption        `config:"decryption"`
+	Table                       table             `config:"table"`
+	Health                      health            `config:"health"`
+	PublishWorkers              int               `config:"publish_workers" validate:"min=1"`
+	Ordering                    string            `config:"ordering"`
+	KeySharedPolicy             keySharedPolicy   `config:"key_shared_policy"`
+	MaxInflightMessages         int               `config:"max_inflight_messages" validate:"min=0"`
+	RateLimit                   RateLimit         `config:"rate_limit"`
+	Filter                      filter            `config:"filter"`
+	Sampling                    sampling          `config:"sampling"`
+	Decompression               decompression     `config:"decompression"`
+	Codec                       *common.Config    `config:"codec"`
+	TraceContext                traceContext      `config:"trace_context"`
+}
+
+// nackBackoff delays the redelivery of a negatively acknowledged message by
+// min_delay, multiplied by multiplier for every redelivery up to max_delay.
+type nackBackoff struct {
+	Enabled    bool          `config:"enabled"`
+	MinDelay   time.Duration `config:"min_delay" validate:"min=0"`
+	MaxDelay   time.Duration `config:"max_delay" validate:"min=0"`
+	Multiplier float64       `config:"multiplier"`
+}
+
+type traceContext struct {
+	Enabled      bool `config:"enabled"`
+	Transactions bool `config:"transactions"`
+}
+
+type decompression struct {
+	Enabled          bool             `config:"enabled"`
+	Property         string           `config:"property"`
+	DetectMagicBytes bool             `config:"detect_magic_bytes"`
+	MaxSize          cfgtype.ByteSize `config:"max_size" validate:"min=1"`
+}
+
+type sampling struct {
+	Mode          string  `config:"mode"`
+	Probability   float64 `config:"probability" validate:"min=0"`
+	ReservoirSize int     `config:"reservoir_size" validate:"min=0"`
+}
+
+type filter struct {
+	Include      *conditions.Config `config:"include"`
+	Exclude      *conditions.Config `config:"exclude"`
+	PrefixLength int                `config:"payload_prefix_length" validate:"min=0"`
+}
+
+// RateLimit throttles the messages received by a consumer. It is exported as
+// it can be adjusted at runtime.
+type RateLimit struct {
+	MessagesPerSecond float64 `config:"messages_per_second" validate:"min=0"`
+	MessagesBurst     int     `config:"messages_burst" validate:"min=0"`
+	BytesPerSecond    float64 `config:"bytes_per_second" validate:"min=0"`
+	BytesBurst        int     `config:"bytes_burst" validate:"min=0"`
+}
+
+type keySharedPolicy struct {
+	Mode                    string      `config:"mode"`
+	HashRanges              []hashRange `config:"hash_ranges"`
+	AllowOutOfOrderDelivery bool        `config:"allow_out_of_order_delivery"`
+}
+
+// hashRange is an inclusive range of the key hashes of a KeyShared
+// subscription, which are within 0 and maxKeyHash.
+type hashRange struct {
+	Start int `config:"start" validate:"min=0,max=65535"`
+	End   int `config:"end" validate:"min=0,max=65535"`
+}
+
+const maxKeyHash = 65535
+
+type table struct {
+	Enabled         bool `config:"enabled"`
+	ResyncOnStartup bool `config:"resync_on_startup"`
+}
+
+type health struct {
+	ExpectMessagesWithin time.Duration `config:"expect_messages_within" validate:"min=0"`
+	Topics               []topicHealth `config:"topics"`
 }
 
+type topicHealth struct {
+	Topic                string        `config:"topic" validate:"required"`
+	ExpectMessagesWithin time.Duration `config:"expect_messages_within" validate:"min=0"`
+}
+
+const (
+	RunModeContinuous    = "continuous"
+	RunModeUntilCaughtUp = "until_caught_up"
+)
+
+const (
+	OrderingNone   = "none"
+	OrderingPerKey = "per_key"
+)
+
+const (
+	SamplingProbability = "probability"
+	SamplingKeyHash     = "key_hash"
+	SamplingReservoir   = "reservoir"
+)
+
+const (
+	CodecPlain       = "plain"
+	CodecCloudEvents = "cloudevents"
+	CodecOTLP        = "otlp"
+	CodecPrometheus  = "prometheus"
+)
+
+const (
+	PrometheusGroupBySample = "sample"
+	PrometheusGroupByFamily = "family"
+
+	PrometheusFormatAuto        = "auto"
+	PrometheusFormatPrometheus  = "prometheus"
+	PrometheusFormatOpenMetrics = "openmetrics"
+)
+
+const (
+	topicsPatternModePersistent    = "persistent"
+	topicsPatternModeNonPersistent = "non_persistent"
+	topicsPatternModeAll           = "all"
+)
+
+const (
+	topicsPatternDiscoveryNamespace = "namespace"
+	topicsPatternDiscoveryCluster   = "cluster"
+)
+
+const (
+	subscriptionModeDurable    = "durable"
+	subscriptionModeNonDurable = "non_durable"
+)
+
+const (
+	keySharedModeAutoSplit = "auto_split"
+	keySharedModeSticky    = "sticky"
+)
+
 type authProvider int
 
 const (
 	authProviderNone authProvider = iota
 	authProviderTLS
 	authProviderAthenz
+	authProviderToken
+	authProviderOAuth2
 )
 
+// defaultTopic is subscribed unless a list of topics or a topics pattern is
+// configured instead.
+const defaultTopic = "my-topic"
+
 var DefaultConfig = Config{
+	RunMode:     RunModeContinuous,
+	IdleTimeout: 30 * time.Second,
 	Client: pulsarClientOptions{
 		URL:               "pulsar://localhost:6650",
 		ConnectionTimeout: 20 * time.Second,
 	},
 	Consumer: pulsarConsumerOptions{
-		Topic:            "my-topic",
+		Enabled:          true,
+		Topic:            defaultTopic,
 		SubscriptionName: "my-sub",
 		NumWorkers:       1,
+		PublishWorkers:   1,
+		Ordering:         OrderingNone,
+		Filter: filter{
+			PrefixLength: 128,
+		},
+		Decompression: decompression{
+			Property:         "content-encoding",
+			DetectMagicBytes: true,
+			MaxSize:          10 * 1024 * 1024,
+		},
+		NackBackoff: nackBackoff{
+			MinDelay:   time.Second,
+			MaxDelay:   10 * time.Minute,
+			Multiplier: 2,
+		},
+		TraceContext: traceContext{
+			Enabled: true,
+		},
 	},
 }
 
+// defaultClientName names the unnamed client section in metrics.
+const defaultClientName = "default"
+
+// NamedClients returns the settings of the client section and of the clients
+// section by name. The name of the client section may be empty, and is the
+// one consumers use unless they name another client.
+func (c *Config) NamedClients() (map[string]pulsarClientOptions, error) {
+	clients := map[string]pulsarClientOptions{c.Client.Name: c.Client}
+	for _, client := range c.Clients {
+		if client.Name == "" {
+			return nil, errors.New("Clients require a name")
+		}
+		if _, ok := clients[client.Name]; ok {
+			return nil, errors.Errorf("Client name %s is not unique", client.Name)
+		}
+		if client.Name == defaultClientName && c.Client.Name == "" {
+			// the metrics of the unnamed client section use this name
+			return nil, errors.Errorf("Client name %s is reserved for the client section", client.Name)
+		}
+		clients[client.Name] = client
+	}
+	return clients, nil
+}
+
+// ResolveClient returns the name of the client a consumer naming the given
+// client subscribes with. An empty name refers to the client section, whatever
+// its name is.
+func (c *Config) ResolveClient(name string) string {
+	if name == "" {
+		return c.Client.Name
+	}
+	return name
+}
+
+func (c *pulsarClientOptions) tlsVersionValidate() error {
+	if c.TLSMinVersion != 0 && c.TLSMaxVersion != 0 && c.TLSMinVersion > c.TLSMaxVersion {
+		return errors.Errorf("TLS min version %s is greater than max version %s", c.TLSMinVersion, c.TLSMaxVersion)
+	}
+	return nil
+}
+
+// connectionMaxIdleTimeValidate accepts 0 for the client default, a negative
+// duration to keep idle connections, or at least the minimum of 60s.
+func (c *pulsarClientOptions) connectionMaxIdleTimeValidate() error {
+	if c.ConnectionMaxIdleTime > 0 && c.ConnectionMaxIdleTime < time.Minute {
+		return errors.Errorf("Connection max idle time %s is less than 60s", c.ConnectionMaxIdleTime)
+	}
+	return nil
+}
+
+func (c *pulsarClientOptions) metricsCardinalityValidate() (pulsar.MetricsCardinality, error) {
+	switch c.MetricsCardinality {
+	case "":
+		return 0, nil
+	case "none":
+		return pulsar.MetricsCardinalityNone, nil
+	case "tenant":
+		return pulsar.MetricsCardinalityTenant, nil
+	case "namespace":
+		return pulsar.MetricsCardinalityNamespace, nil
+	case "topic":
+		return pulsar.MetricsCardinalityTopic, nil
+	default:
+		return 0, unknownValueError("metrics cardinality", c.MetricsCardinality, "none", "tenant", "namespace", "topic")
+	}
+}
+
 func (c *pulsarClientOptions) authValidate() (authProvider, error) {
+	token := c.AuthenticationToken.Token != ""
+	oauth2 := c.AuthenticationOAuth2 != authenticationOAuth2{}
 	if len(c.AuthenticationAthenz) == 0 &&
 		c.AuthenticationTLS.CertificatePath == "" && c.AuthenticationTLS.PrivateKeyPath == "" {
-		return authProviderNone, nil
+		switch {
+		case token && oauth2:
+			return authProviderNone, errors.New("Multiple authentication settings are configured")
+		case token:
+			return authProviderToken, nil
+		case oauth2:
+			return authProviderOAuth2, c.AuthenticationOAuth2.oauth2Validate()
+		default:
+			return authProviderNone, nil
+		}
+	}
+	if token || oauth2 {
+		return authProviderNone, errors.New("Multiple authentication settings are configured")
 	}
 
 	var ok bool
@@ -123,15 +436,213 @@ func (c *pulsarClientOptions) authValidate() (authProvider, error) {
 	}
 }
 
+func (b *nackBackoff) nackBackoffValidate() (pulsar.NackBackoffPolicy, error) {
+	if !b.Enabled {
+		return nil, nil
+	}
+	if b.MinDelay <= 0 {
+		return nil, errors.New("Min delay must be positive")
+	}
+	if b.MaxDelay < b.MinDelay {
+		return nil, errors.Errorf("Max delay %s is less than min delay %s", b.MaxDelay, b.MinDelay)
+	}
+	if b.Multiplier < 1 {
+		return nil, errors.Errorf("Multiplier %v is less than 1", b.Multiplier)
+	}
+	return &expNackBackoffPolicy{minDelay: b.MinDelay, maxDelay: b.MaxDelay, multiplier: b.Multiplier}, nil
+}
+
+type expNackBackoffPolicy struct {
+	minDelay   time.Duration
+	maxDelay   time.Duration
+	multiplier float64
+}
+
+// Next returns the delay of the redelivery following redeliveryCount ones.
+func (p *expNackBackoffPolicy) Next(redeliveryCount uint32) time.Duration {
+	delay := float64(p.minDelay) * math.Pow(p.multiplier, float64(redeliveryCount))
+	if delay >= float64(p.maxDelay) {
+		return p.maxDelay
+	}
+	return time.Duration(delay)
+}
+
+func (c *pulsarConsumerOptions) topicsPatternValidate() error {
+	switch c.TopicsPatternMode {
+	case "", topicsPatternModePersistent, topicsPatternModeNonPersistent, topicsPatternModeAll:
+	default:
+		return unknownValueError("topics pattern mode", c.TopicsPatternMode,
+			topicsPatternModePersistent, topicsPatternModeNonPersistent, topicsPatternModeAll)
+	}
+	switch c.TopicsPatternDiscovery {
+	case "", topicsPatternDiscoveryNamespace, topicsPatternDiscoveryCluster:
+	default:
+		return unknownValueError("topics pattern discovery", c.TopicsPatternDiscovery,
+			topicsPatternDiscoveryNamespace, topicsPatternDiscoveryCluster)
+	}
+	if _, err := regexp.Compile(c.TopicsPattern); err != nil {
+		return errors.Wrap(err, "Invalid topics pattern")
+	}
+	return nil
+}
+
+// DiscoversTopics reports whether the topics matching the topics pattern are
+// looked up through the admin API, see DiscoverTopics. Otherwise the pulsar
+// client subscribes by the pattern, which covers the persistent topics of a
+// single namespace.
+func (c *pulsarConsumerOptions) DiscoversTopics() bool {
+	if c.TopicsPattern == "" {
+		return false
+	}
+	return (c.TopicsPatternMode != "" && c.TopicsPatternMode != topicsPatternModePersistent) ||
+		c.TopicsPatternDiscovery == topicsPatternDiscoveryCluster
+}
+
+func (c *pulsarConsumerOptions) subscriptionTypeValidate() (pulsar.SubscriptionType, error) {
+	switch c.Type {
+	case "", "Exclusive":
+		return pulsar.Exclusive, nil
+	case "Shared":
+		return pulsar.Shared, nil
+	case "Failover":
+		return pulsar.Failover, nil
+	case "KeyShared":
+		return pulsar.KeyShared, nil
+	default:
+		return pulsar.Exclusive, unknownValueError("subscription type", c.Type,
+			"Exclusive", "Shared", "Failover", "KeyShared")
+	}
+}
+
+func (c *pulsarConsumerOptions) orderingValidate(subscriptionType pulsar.SubscriptionType) error {
+	switch c.Ordering {
+	case "", OrderingNone:
+	case OrderingPerKey:
+		if subscriptionType == pulsar.Shared {
+			return errors.New("Ordering per_key requires an Exclusive, Failover or KeyShared subscription")
+		}
+		if c.KeySharedPolicy.AllowOutOfOrderDelivery {
+			return errors.New("Ordering per_key can not be combined with out of order delivery")
+		}
+	default:
+		return unknownValueError("ordering", c.Ordering, OrderingNone, OrderingPerKey)
+	}
+	return nil
+}
+
+func (c *pulsarConsumerOptions) subscriptionModeValidate() (pulsar.SubscriptionMode, error) {
+	switch c.SubscriptionMode {
+	case "", subscriptionModeDurable:
+		return pulsar.Durable, nil
+	case subscriptionModeNonDurable:
+		return pulsar.NonDurable, nil
+	default:
+		return pulsar.Durable, unknownValueError("subscription mode", c.SubscriptionMode,
+			subscriptionModeDurable, subscriptionModeNonDurable)
+	}
+}
+
+func (p *keySharedPolicy) keySharedPolicyValidate(subscriptionType pulsar.SubscriptionType) (*pulsar.KeySharedPolicy, error) {
+	if p.Mode == "" && len(p.HashRanges) == 0 && !p.AllowOutOfOrderDelivery {
+		return nil, nil
+	}
+	if subscriptionType != pulsar.KeyShared {
+		return nil, errors.New("KeyShared policy is configured for a non KeyShared subscription")
+	}
+
+	var policy *pulsar.KeySharedPolicy
+	switch p.Mode {
+	case "", keySharedModeAutoSplit:
+		if len(p.HashRanges) != 0 {
+			return nil, errors.New("Hash ranges require the sticky mode")
+		}
+		policy = &pulsar.KeySharedPolicy{Mode: pulsar.KeySharedPolicyModeAutoSplit}
+	case keySharedModeSticky:
+		if len(p.HashRanges) == 0 {
+			return nil, errors.New("Sticky mode requires hash ranges")
+		}
+		var hashRanges []int
+		for _, r := range p.HashRanges {
+			if r.Start < 0 || r.End > maxKeyHash || r.Start > r.End {
+				return nil, errors.Errorf("Hash range %d-%d is not within 0 and %d", r.Start, r.End, maxKeyHash)
+			}
+			hashRanges = append(hashRanges, r.Start, r.End)
+		}
+		var err error
+		policy, err = pulsar.NewKeySharedPolicySticky(hashRanges)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, unknownValueError("KeyShared policy mode", p.Mode, keySharedModeAutoSplit, keySharedModeSticky)
+	}
+	policy.AllowOutOfOrderDelivery = p.AllowOutOfOrderDelivery
+	return policy, nil
+}
+
+// initialPositionValidate returns the position a new subscription starts at,
+// and the time it is rewound to if the position is an RFC3339 timestamp or a
+// negative duration relative to now, like -2h.
+func (c *pulsarConsumerOptions) initialPositionValidate(now time.Time) (pulsar.SubscriptionInitialPosition, time.Time, error) {
+	var position time.Time
+	switch {
+	case c.SubscriptionInitialPosition == "Earliest":
+		return pulsar.SubscriptionPositionEarliest, position, nil
+	case c.SubscriptionInitialPosition == "" || c.SubscriptionInitialPosition == "Latest":
+		return pulsar.SubscriptionPositionLatest, position, nil
+	case strings.HasPrefix(c.SubscriptionInitialPosition, "-"):
+		d, err := time.ParseDuration(c.SubscriptionInitialPosition)
+		if err != nil {
+			return 0, position, err
+		}
+		position = now.Add(d)
+	default:
+		t, err := time.Parse(time.RFC3339, c.SubscriptionInitialPosition)
+		if err != nil {
+			return 0, position, unknownValueError("initial position", c.SubscriptionInitialPosition,
+				"Earliest", "Latest")
+		}
+		position = t
+	}
+
+	if c.Topic == "" || len(c.Topics) != 0 || c.TopicsPattern != "" {
+		return 0, position, errors.New("Initial position by time requires a single topic")
+	}
+	return pulsar.SubscriptionPositionLatest, position, nil
+}
+
 func NewPulsarClient(clientOptions pulsarClientOptions) (*pulsar.Client, error) {
 	var clientConfig pulsar.ClientOptions
 	clientConfig.URL = clientOptions.URL
 	clientConfig.ConnectionTimeout = clientOptions.ConnectionTimeout
 	clientConfig.OperationTimeout = clientOptions.OperationTimeout
-	clientConfig.TLSTrustCertsFilePath = clientOptions.TLSTrustCertsFilePath
+	clientConfig.TLSTrustCertsFilePath = strings.TrimPrefix(clientOptions.TLSTrustCertsFilePath, fileURIPrefix)
 	clientConfig.TLSAllowInsecureConnection = clientOptions.TLSAllowInsecureConnection
 	clientConfig.TLSValidateHostname = clientOptions.TLSValidateHostname
+	clientConfig.TLSMinVersion = uint16(clientOptions.TLSMinVersion)
+	clientConfig.TLSMaxVersion = uint16(clientOptions.TLSMaxVersion)
+	for _, cipherSuite := range clientOptions.TLSCipherSuites {
+		clientConfig.TLSCipherSuites = append(clientConfig.TLSCipherSuites, uint16(cipherSuite))
+	}
 	clientConfig.MaxConnectionsPerBroker = clientOptions.MaxConnectionsPerBroker
+	clientConfig.ListenerName = clientOptions.ListenerName
+	clientConfig.MemoryLimitBytes = clientOptions.MemoryLimitBytes
+	clientConfig.KeepAliveInterval = clientOptions.KeepAliveInterval
+
+	if err := clientOptions.tlsVersionValidate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid TLS Version Settings")
+	}
+
+	if err := clientOptions.connectionMaxIdleTimeValidate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Connection Max Idle Time Settings")
+	}
+	clientConfig.ConnectionMaxIdleTime = clientOptions.ConnectionMaxIdleTime
+
+	metricsCardinality, err := clientOptions.metricsCardinalityValidate()
+	if err != nil {
+		return nil, errors.Wrap(err, "Invalid Metrics Cardinality Settings")
+	}
+	clientConfig.MetricsCardinality = metricsCardinality
 
 	auth, err := clientOptions.authValidate()
 	if err != nil {
@@ -139,12 +650,22 @@ func NewPulsarClient(clientOptions pulsarClientOptions) (*pulsar.Client, error)
 	}
 	switch auth {
 	case authProviderAthenz:
-		clientConfig.Authentication = pulsar.NewAuthenticationAthenz(clientOptions.AuthenticationAthenz)
+		clientConfig.Authentication = pulsar.NewAuthenticationAthenz(athenzParams(clientOptions.AuthenticationAthenz))
 	case authProviderTLS:
-		clientConfig.Authentication = pulsar.NewAuthenticationTLS(clientOptions.AuthenticationTLS.CertificatePath,
-			clientOptions.AuthenticationTLS.PrivateKeyPath)
+		clientConfig.Authentication, err = clientOptions.AuthenticationTLS.authentication()
+	case authProviderToken:
+		clientConfig.Authentication = clientOptions.AuthenticationToken.authentication()
+	case authProviderOAuth2:
+		var params map[string]string
+		params, err = clientOptions.AuthenticationOAuth2.params()
+		if err == nil {
+			clientConfig.Authentication = pulsar.NewAuthenticationOAuth2(params)
+		}
 	default:
 	}
+	if err != nil {
+		return nil, errors.Wrap(err, "Loading Authentication Credentials")
+	}
 
 	client, err := pulsar.NewClient(clientConfig)
 	if err != nil {
@@ -154,43 +675,168 @@ func NewPulsarClient(clientOptions pulsarClientOptions) (*pulsar.Client, error)
 	return &client, nil
 }
 
-func NewPulsarConsumer(client *pulsar.Client, consumerOptions pulsarConsumerOptions) (*[]pulsar.Consumer, error) {
+// NewPulsarConsumer subscribes the consumers of consumerOptions. The admin
+// client may be nil unless the initial position is a time or the table of
+// several topics is resynced.
+func NewPulsarConsumer(client *pulsar.Client, admin pulsaradmin.Client, consumerOptions pulsarConsumerOptions) (*[]pulsar.Consumer, error) {
 	var consumerConfig pulsar.ConsumerOptions
 	consumerConfig.Topic = consumerOptions.Topic
+	consumerConfig.Topics = consumerOptions.Topics
+	consumerConfig.TopicsPattern = consumerOptions.TopicsPattern
 	consumerConfig.AutoDiscoveryPeriod = consumerOptions.AutoDiscoveryPeriod
 	consumerConfig.SubscriptionName = consumerOptions.SubscriptionName
 	consumerConfig.Properties = consumerOptions.Properties
 
-	switch consumerOptions.Type {
-	case "Shared":
-		consumerConfig.Type = pulsar.Shared
-	case "Failover":
-		consumerConfig.Type = pulsar.Failover
-	case "KeyShared":
-		consumerConfig.Type = pulsar.KeyShared
-	default:
-		consumerConfig.Type = pulsar.Exclusive
+	if err := consumerOptions.topicsPatternValidate(); err != nil {
+		return nil, errors.Wrap(err, "Invalid Topics Pattern Settings")
+	}
+	if consumerOptions.DiscoversTopics() {
+		return nil, errors.Errorf("Topics pattern mode %s or discovery %s requires the topics to be discovered first",
+			consumerOptions.TopicsPatternMode, consumerOptions.TopicsPatternDiscovery)
 	}
 
-	if consumerOptions.SubscriptionInitialPosition == "Earliest" {
-		consumerConfig.SubscriptionInitialPosition = pulsar.SubscriptionPositionEarliest
-	} else {
-		consumerConfig.SubscriptionInitialPosition = pulsar.SubscriptionPositionLatest
+	subscriptionType, err := consumerOptions.subscriptionTypeValidate()
+	if err != nil {
+		return nil, errors.Wrap(err, "Invalid Subscription Type Settings")
+	}
+	consumerConfig.Type = subscriptionType
+
+	initialPosition, rewindTo, err := consumerOptions.initialPositionValidate(time.Now())
+	if err != nil {
+		return nil, errors.Wrap(err, "Invalid Initial Position Settings")
+	}
+	consumerConfig.SubscriptionInitialPosition = initialPosition
+
+	// a subscription is only rewound to the initial position when it is created
+	rewind := false
+	if !rewindTo.IsZero() {
+		if admin == nil {
+			return nil, errors.New("Initial position by time requires the admin_url setting")
+		}
+		exists, err := subscriptionExists(admin, consumerOptions.Topic, consumerOptions.SubscriptionName)
+		if err != nil {
+			return nil, errors.Wrap(err, "Looking up pulsar subscription")
+		}
+		rewind = !exists
 	}
 
 	consumerConfig.ReceiverQueueSize = consumerOptions.ReceiverQueueSize
 	consumerConfig.NackRedeliveryDelay = consumerOptions.NackRedeliveryDelay
 	consumerConfig.ReadCompacted = consumerOptions.ReadCompacted
 	consumerConfig.ReplicateSubscriptionState = consumerOptions.ReplicateSubscriptionState
+	consumerConfig.EnableBatchIndexAcknowledgment = consumerOptions.EnableBatchIndexAck
+	consumerConfig.MaxPendingChunkedMessage = consumerOptions.MaxPendingChunkedMessage
+	consumerConfig.ExpireTimeOfIncompleteChunk = consumerOptions.ExpireTimeOfIncompleteChunk
+	consumerConfig.SubscriptionProperties = consumerOptions.SubscriptionProperties
+
+	nackBackoffPolicy, err := consumerOptions.NackBackoff.nackBackoffValidate()
+	if err != nil {
+		return nil, errors.Wrap(err, "Invalid Nack Backoff Settings")
+	}
+	consumerConfig.NackBackoffPolicy = nackBackoffPolicy
+
+	subscriptionMode, err := consumerOptions.subscriptionModeValidate()
+	if err != nil {
+		return nil, errors.Wrap(err, "Invalid Subscription Mode Settings")
+	}
+	consumerConfig.SubscriptionMode = subscriptionMode
+
+	keySharedPolicy, err := consumerOptions.KeySharedPolicy.keySharedPolicyValidate(consumerConfig.Type)
+	if err != nil {
+		return nil, errors.Wrap(err, "Invalid KeyShared Policy Settings")
+	}
+	consumerConfig.KeySharedPolicy = keySharedPolicy
+
+	if err := consumerOptions.orderingValidate(consumerConfig.Type); err != nil {
+		return nil, errors.Wrap(err, "Invalid Ordering Settings")
+	}
+
+	decryptionInfo, err := consumerOptions.Decryption.decryptionValidate()
+	if err != nil {
+		return nil, errors.Wrap(err, "Invalid Decryption Settings")
+	}
+	consumerConfig.Decryption = decryptionInfo
 
 	var consumers []pulsar.Consumer
 	for i := 1; i <= consumerOptions.NumWorkers; i++ {
 		consumerConfig.Name = consumerOptions.Name + "-" + strconv.Itoa(i)
 		consumer, err := (*client).Subscribe(consumerConfig)
 		if err != nil {
+			closeConsumers(consumers)
 			return nil, errors.Wrap(err, "Initializing pulsar consumer")
 		}
 		consumers = append(consumers, consumer)
 	}
+
+	if rewind {
+		if err := consumers[0].SeekByTime(rewindTo); err != nil {
+			// remove the subscription again, so that it is rewound on the next start
+			closeConsumers(consumers[1:])
+			if err := consumers[0].UnsubscribeForce(); err != nil {
+				logp.Warn("Removing subscription %s failed: %v", consumerOptions.SubscriptionName, err)
+			}
+			return nil, errors.Wrap(err, "Rewinding new pulsar subscription to initial position")
+		}
+	}
+
+	if consumerOptions.Table.Enabled && consumerOptions.Table.ResyncOnStartup {
+		if err := resyncTable(consumers[0], admin, consumerOptions); err != nil {
+			closeConsumers(consumers)
+			return nil, errors.Wrap(err, "Rewinding pulsar subscription for table resync")
+		}
+	}
 	return &consumers, nil
 }
+
+// Resubscribing returns the options of subscribing again to the subscription
+// created with c, e.g. after switching the service URL. The subscription
+// exists already, so it is neither rewound to a time based initial position
+// nor resynced again, which both require the admin API.
+func (c pulsarConsumerOptions) Resubscribing() pulsarConsumerOptions {
+	switch c.SubscriptionInitialPosition {
+	case "", "Earliest", "Latest":
+	default:
+		c.SubscriptionInitialPosition = "Latest"
+	}
+	c.Table.ResyncOnStartup = false
+	return c
+}
+
+// resyncTable rewinds the subscription to the earliest message. The cursor
+// belongs to the subscription, so rewinding it through one consumer is enough.
+// Seeking by time rewinds every partition of a single topic, while consumers
+// of several topics can not seek and their cursors are reset through the
+// admin API instead.
+func resyncTable(consumer pulsar.Consumer, admin pulsaradmin.Client, consumerOptions pulsarConsumerOptions) error {
+	if consumerOptions.Topic != "" {
+		return consumer.SeekByTime(time.Unix(0, 0))
+	}
+	if admin == nil {
+		return errors.New("Resyncing several topics requires the admin_url setting")
+	}
+
+	topics := consumerOptions.Topics
+	if consumerOptions.TopicsPattern != "" {
+		var err error
+		topics, err = DiscoverTopics(admin, consumerOptions)
+		if err != nil {
+			return err
+		}
+	}
+	for _, topic := range topics {
+		topicName, err := utils.GetTopicName(topic)
+		if err != nil {
+			return err
+		}
+		if err := admin.Subscriptions().ResetCursorToTimestamp(*topicName, consumerOptions.SubscriptionName, 0); err != nil {
+			return errors.Wrapf(err, "Resetting cursor of %s", topic)
+		}
+	}
+	return nil
+}
+
+func closeConsumers(consumers []pulsar.Consumer) {
+	for _, consumer := range consumers {
+		consumer.Close()
+	}
+}
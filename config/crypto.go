@@ -0,0 +1,108 @@
+package config
+
+import (
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/apache/pulsar-client-go/pulsar/crypto"
+	"github.com/pkg/errors"
+	"io/ioutil"
+	"os"
+)
+
+type decryption struct {
+	PrivateKeyFiles     map[string]string `config:"private_key_files"`
+	PrivateKeys         map[string]string `config:"private_keys"`
+	CryptoFailureAction string            `config:"crypto_failure_action"`
+}
+
+const (
+	cryptoFailureActionFail    = "fail"
+	cryptoFailureActionDiscard = "discard"
+	cryptoFailureActionConsume = "consume"
+)
+
+// Enabled reports whether any private key is configured for decryption.
+func (d decryption) Enabled() bool {
+	return len(d.PrivateKeyFiles) != 0 || len(d.PrivateKeys) != 0
+}
+
+// ConsumeUndecrypted reports whether messages failing decryption are delivered
+// with their encrypted payload instead of being failed or discarded.
+func (d decryption) ConsumeUndecrypted() bool {
+	return d.Enabled() && d.CryptoFailureAction == cryptoFailureActionConsume
+}
+
+// cryptoFailureActionValidate returns the action on decryption failures.
+// Unlike decryptionValidate, it does not access the key files, so that it also
+// runs when the configuration is loaded.
+func (d decryption) cryptoFailureActionValidate() (int, error) {
+	if !d.Enabled() {
+		if d.CryptoFailureAction != "" {
+			return 0, errors.New("crypto_failure_action is configured without any private key")
+		}
+		return 0, nil
+	}
+
+	switch d.CryptoFailureAction {
+	case "", cryptoFailureActionFail:
+		return crypto.ConsumerCryptoFailureActionFail, nil
+	case cryptoFailureActionDiscard:
+		return crypto.ConsumerCryptoFailureActionDiscard, nil
+	case cryptoFailureActionConsume:
+		return crypto.ConsumerCryptoFailureActionConsume, nil
+	default:
+		return 0, unknownValueError("crypto_failure_action", d.CryptoFailureAction,
+			cryptoFailureActionFail, cryptoFailureActionDiscard, cryptoFailureActionConsume)
+	}
+}
+
+func (d decryption) decryptionValidate() (*pulsar.MessageDecryptionInfo, error) {
+	action, err := d.cryptoFailureActionValidate()
+	if err != nil || !d.Enabled() {
+		return nil, err
+	}
+
+	for name, path := range d.PrivateKeyFiles {
+		if _, ok := d.PrivateKeys[name]; ok {
+			return nil, errors.Errorf("Private key %s is configured both as file and as key", name)
+		}
+		if _, err := os.Stat(path); err != nil {
+			return nil, errors.Wrapf(err, "Private key file of %s", name)
+		}
+	}
+
+	return &pulsar.MessageDecryptionInfo{
+		KeyReader: &keyReader{
+			files: d.PrivateKeyFiles,
+			keys:  d.PrivateKeys,
+		},
+		ConsumerCryptoFailureAction: action,
+	}, nil
+}
+
+// keyReader implements crypto.KeyReader for consumers. Private keys are either
+// read from files on every lookup, so that rotated keys are picked up, or taken
+// as PEM content directly from the configuration (e.g. keystore references).
+type keyReader struct {
+	files map[string]string
+	keys  map[string]string
+}
+
+func (r *keyReader) PublicKey(keyName string, metadata map[string]string) (*crypto.EncryptionKeyInfo, error) {
+	return nil, errors.Errorf("Public key %s requested by a consumer", keyName)
+}
+
+func (r *keyReader) PrivateKey(keyName string, metadata map[string]string) (*crypto.EncryptionKeyInfo, error) {
+	if key, ok := r.keys[keyName]; ok {
+		return crypto.NewEncryptionKeyInfo(keyName, []byte(key), metadata), nil
+	}
+
+	path, ok := r.files[keyName]
+	if !ok {
+		return nil, errors.Errorf("Private key %s is not configured", keyName)
+	}
+	key, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Reading private key %s", keyName)
+	}
+	return crypto.NewEncryptionKeyInfo(keyName, key, metadata), nil
+}
@@ -0,0 +1,161 @@
+package config
+
+import (
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pkg/errors"
+	"io/ioutil"
+	"strings"
+)
+
+// Credential settings accept references to the beats keystore like
+// ${pulsar.token}, which are resolved when unpacking the configuration, and
+// file:// URIs of files holding the credential. Certificates and keys may also
+// be given as PEM content, e.g. stored in the keystore, instead of a path.
+
+const fileURIPrefix = "file://"
+
+// secretValue returns value, or the content of the file of a file:// URI
+// without surrounding whitespace.
+func secretValue(value string) (string, error) {
+	if !strings.HasPrefix(value, fileURIPrefix) {
+		return value, nil
+	}
+	data, err := ioutil.ReadFile(strings.TrimPrefix(value, fileURIPrefix))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// isPEM reports whether value is PEM content rather than a path.
+func isPEM(value string) bool {
+	return strings.HasPrefix(strings.TrimSpace(value), "-----BEGIN")
+}
+
+// pemValue returns value if it is PEM content, or the content of the file of
+// the path or file:// URI value.
+func pemValue(value string) ([]byte, error) {
+	if isPEM(value) {
+		return []byte(value), nil
+	}
+	return ioutil.ReadFile(strings.TrimPrefix(value, fileURIPrefix))
+}
+
+func (t *authenticationTLS) authentication() (pulsar.Authentication, error) {
+	if !isPEM(t.CertificatePath) && !isPEM(t.PrivateKeyPath) {
+		return pulsar.NewAuthenticationTLS(strings.TrimPrefix(t.CertificatePath, fileURIPrefix),
+			strings.TrimPrefix(t.PrivateKeyPath, fileURIPrefix)), nil
+	}
+
+	certificatePEM, err := pemValue(t.CertificatePath)
+	if err != nil {
+		return nil, err
+	}
+	keyPEM, err := pemValue(t.PrivateKeyPath)
+	if err != nil {
+		return nil, err
+	}
+	certificate, err := tls.X509KeyPair(certificatePEM, keyPEM)
+	if err != nil {
+		return nil, err
+	}
+	return pulsar.NewAuthenticationFromTLSCertSupplier(func() (*tls.Certificate, error) {
+		return &certificate, nil
+	}), nil
+}
+
+// authentication reads a token of a file:// URI whenever authenticating, so
+// that a rotated token is picked up.
+func (t *authenticationToken) authentication() pulsar.Authentication {
+	if strings.HasPrefix(t.Token, fileURIPrefix) {
+		return pulsar.NewAuthenticationTokenFromFile(strings.TrimPrefix(t.Token, fileURIPrefix))
+	}
+	return pulsar.NewAuthenticationToken(t.Token)
+}
+
+// athenzParams passes a private key given as PEM content as data URI, as the
+// Athenz provider only accepts file and data URIs.
+func athenzParams(params map[string]string) map[string]string {
+	if !isPEM(params["privateKey"]) {
+		return params
+	}
+	converted := make(map[string]string, len(params))
+	for k, v := range params {
+		converted[k] = v
+	}
+	converted["privateKey"] = "data:application/x-pem-file;base64," +
+		base64.StdEncoding.EncodeToString([]byte(params["privateKey"]))
+	return converted
+}
+
+func (o *authenticationOAuth2) oauth2Validate() error {
+	if o.IssuerURL == "" {
+		return errors.New("OAuth2 issuer_url is not configured")
+	}
+	if (o.ClientSecret == "") == (o.PrivateKey == "") {
+		return errors.New("Either OAuth2 client_secret or private_key is required")
+	}
+	if o.ClientSecret != "" && o.ClientID == "" {
+		return errors.New("OAuth2 client_secret requires client_id")
+	}
+	return nil
+}
+
+// params returns the parameters of the client credentials flow. A client
+// secret is passed as key file of a data URI.
+func (o *authenticationOAuth2) params() (map[string]string, error) {
+	params := map[string]string{
+		"type":       "client_credentials",
+		"issuerUrl":  o.IssuerURL,
+		"audience":   o.Audience,
+		"scope":      o.Scope,
+		"clientId":   o.ClientID,
+		"privateKey": o.PrivateKey,
+	}
+	if o.ClientSecret != "" {
+		secret, err := secretValue(o.ClientSecret)
+		if err != nil {
+			return nil, err
+		}
+		keyFile, err := json.Marshal(map[string]string{
+			"type":          "client_credentials",
+			"client_id":     o.ClientID,
+			"client_secret": secret,
+			"issuer_url":    o.IssuerURL,
+		})
+		if err != nil {
+			return nil, err
+		}
+		params["privateKey"] = "data://" + string(keyFile)
+	}
+	return params, nil
+}
+
+// CredentialFiles returns the files the credentials are read from when
+// creating the client, which is re-created when they are rotated.
+func (c *pulsarClientOptions) CredentialFiles() []string {
+	var files []string
+	addPath := func(value string) {
+		if value != "" && !isPEM(value) {
+			files = append(files, strings.TrimPrefix(value, fileURIPrefix))
+		}
+	}
+	addURI := func(value string) {
+		if strings.HasPrefix(value, fileURIPrefix) {
+			files = append(files, strings.TrimPrefix(value, fileURIPrefix))
+		}
+	}
+
+	addPath(c.TLSTrustCertsFilePath)
+	addPath(c.AuthenticationTLS.CertificatePath)
+	addPath(c.AuthenticationTLS.PrivateKeyPath)
+	addURI(c.AuthenticationAthenz["privateKey"])
+	addURI(c.AuthenticationOAuth2.ClientSecret)
+	if !strings.HasPrefix(c.AuthenticationOAuth2.PrivateKey, "data:") {
+		addPath(c.AuthenticationOAuth2.PrivateKey)
+	}
+	return files
+}
@@ -0,0 +1,312 @@
+package config
+
+import (
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/pkg/errors"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Validate checks the settings for unknown values and combinations the pulsar
+// client would silently ignore or fail on. It is called when unpacking the
+// configuration.
+func (c *Config) Validate() error {
+	switch c.RunMode {
+	case "", RunModeContinuous, RunModeUntilCaughtUp:
+	default:
+		return unknownValueError("run_mode", c.RunMode, RunModeContinuous, RunModeUntilCaughtUp)
+	}
+
+	clients, err := c.NamedClients()
+	if err != nil {
+		return errors.Wrap(err, "Invalid Clients Settings")
+	}
+	names := make([]string, 0, len(clients))
+	for name, client := range clients {
+		if err := client.clientValidate(); err != nil {
+			if name == "" {
+				return errors.Wrap(err, "Invalid Client Settings")
+			}
+			return errors.Wrapf(err, "Invalid Client Settings of %s", name)
+		}
+		names = append(names, name)
+	}
+
+	if !c.Consumer.Enabled {
+		if c.RunMode == RunModeUntilCaughtUp {
+			return errors.Errorf("run_mode %s requires the consumer to be enabled", c.RunMode)
+		}
+		if c.Inputs == nil || !c.Inputs.Enabled() {
+			return errors.New("Nothing to consume, the consumer is disabled and config.inputs is not enabled")
+		}
+		return nil
+	}
+	if _, ok := clients[c.ResolveClient(c.Consumer.Client)]; !ok {
+		sort.Strings(names)
+		return unknownValueError("client", c.Consumer.Client, names...)
+	}
+
+	if err := c.Consumer.Validate(); err != nil {
+		return errors.Wrap(err, "Invalid Consumer Settings")
+	}
+	return nil
+}
+
+func (c *pulsarClientOptions) clientValidate() error {
+	if c.MaxLookupRedirects != nil {
+		// rejected instead of being silently ignored
+		return errors.New("max_lookup_redirects is not supported, as the pulsar client does not allow " +
+			"configuring it and always follows up to 20 lookup redirects")
+	}
+	if err := c.tlsURLsValidate(); err != nil {
+		return err
+	}
+	if err := c.tlsVersionValidate(); err != nil {
+		return err
+	}
+	if err := c.connectionMaxIdleTimeValidate(); err != nil {
+		return err
+	}
+	if _, err := c.metricsCardinalityValidate(); err != nil {
+		return err
+	}
+	_, err := c.authValidate()
+	return err
+}
+
+// tlsURLsValidate checks the schemes of the service URLs and of the admin URL
+// against the TLS settings using them. A TLS connection requires a trusted
+// certificate unless the certificate of the server is not verified. The
+// trusted certificate and the TLS authentication apply to the admin URL as
+// well, while TLS versions and cipher suites only apply to the service URLs.
+func (c *pulsarClientOptions) tlsURLsValidate() error {
+	var plainService, tlsService []string
+	for _, serviceURL := range append([]string{c.URL}, c.Failover.Secondaries...) {
+		secure, err := c.urlTLSValidate(serviceURL, "pulsar", "pulsar+ssl", "http", "https")
+		if err != nil {
+			return err
+		}
+		if secure {
+			tlsService = append(tlsService, serviceURL)
+		} else {
+			plainService = append(plainService, serviceURL)
+		}
+	}
+	tlsAdmin := false
+	if c.AdminURL != "" {
+		var err error
+		if tlsAdmin, err = c.urlTLSValidate(c.AdminURL, "http", "https"); err != nil {
+			return errors.Wrap(err, "Invalid Admin URL")
+		}
+	}
+
+	sharedTLS := c.TLSTrustCertsFilePath != "" || c.AuthenticationTLS.CertificatePath != ""
+	serviceTLS := c.TLSMinVersion != 0 || c.TLSMaxVersion != 0 || len(c.TLSCipherSuites) != 0
+	switch {
+	case len(plainService) != 0 && serviceTLS:
+		return errors.Errorf("Service URL %s does not use TLS, but TLS versions or cipher suites are "+
+			"configured, did you mean pulsar+ssl://?", plainService[0])
+	case len(plainService) != 0 && sharedTLS && len(tlsService) != 0:
+		return errors.Errorf("Service URL %s does not use TLS unlike %s, did you mean pulsar+ssl://?",
+			plainService[0], tlsService[0])
+	case sharedTLS && len(tlsService) == 0 && !tlsAdmin:
+		return errors.Errorf("Service URL %s does not use TLS, and neither does an admin_url, but TLS "+
+			"settings are configured, did you mean pulsar+ssl:// or https://?", plainService[0])
+	}
+	return nil
+}
+
+// urlTLSValidate checks that rawURL has one of schemes, which are given in
+// pairs of a plain scheme and its TLS variant, and reports whether it uses TLS.
+func (c *pulsarClientOptions) urlTLSValidate(rawURL string, schemes ...string) (bool, error) {
+	i := strings.Index(rawURL, "://")
+	if i < 0 {
+		return false, errors.Errorf("URL %s has no scheme, like %s://", rawURL, schemes[0])
+	}
+	scheme := rawURL[:i]
+	for j, s := range schemes {
+		if s != scheme {
+			continue
+		}
+		secure := j%2 == 1
+		if secure && c.TLSTrustCertsFilePath == "" && !c.TLSAllowInsecureConnection {
+			return false, errors.Errorf("URL %s uses TLS, but neither tls_trust_certs_file_path "+
+				"nor tls_allow_insecure_connection is configured", rawURL)
+		}
+		return secure, nil
+	}
+	return false, unknownValueError("URL scheme", scheme, schemes...)
+}
+
+// Validate checks the consumer settings. Besides being part of the validation
+// of Config, it validates the consumer settings of inputs loaded from
+// pulsarbeat.config.inputs when they are unpacked. The default topic is
+// dropped if a list of topics or a topics pattern is configured.
+func (c *pulsarConsumerOptions) Validate() error {
+	if !c.Enabled {
+		// a disabled consumer is never subscribed
+		return nil
+	}
+	if c.PriorityLevel != nil {
+		// rejected instead of being silently ignored
+		return errors.New("priority_level is not supported, as the pulsar client always subscribes " +
+			"without a priority level and does not allow configuring it")
+	}
+	if c.Topic == defaultTopic && (len(c.Topics) != 0 || c.TopicsPattern != "") {
+		c.Topic = ""
+	}
+	if c.Topic == "" && len(c.Topics) == 0 && c.TopicsPattern == "" {
+		return errors.New("One of topic, topics or topics_pattern is required")
+	}
+	if c.Topic != "" && (len(c.Topics) != 0 || c.TopicsPattern != "") {
+		return errors.Errorf("Topic %s can not be combined with topics or topics_pattern, "+
+			"which would be ignored", c.Topic)
+	}
+	if len(c.Topics) != 0 && c.TopicsPattern != "" {
+		return errors.New("Topics can not be combined with topics_pattern, which would be ignored")
+	}
+	if err := c.topicsPatternValidate(); err != nil {
+		return errors.Wrap(err, "Invalid Topics Pattern Settings")
+	}
+
+	subscriptionType, err := c.subscriptionTypeValidate()
+	if err != nil {
+		return errors.Wrap(err, "Invalid Subscription Type Settings")
+	}
+	if subscriptionType == pulsar.Exclusive && c.NumWorkers > 1 {
+		return errors.Errorf("num_workers %d requires a Shared, Failover or KeyShared subscription, "+
+			"as an Exclusive subscription accepts a single consumer", c.NumWorkers)
+	}
+
+	if _, _, err := c.initialPositionValidate(time.Now()); err != nil {
+		return errors.Wrap(err, "Invalid Initial Position Settings")
+	}
+	if _, err := c.subscriptionModeValidate(); err != nil {
+		return errors.Wrap(err, "Invalid Subscription Mode Settings")
+	}
+	if _, err := c.KeySharedPolicy.keySharedPolicyValidate(subscriptionType); err != nil {
+		return errors.Wrap(err, "Invalid KeyShared Policy Settings")
+	}
+	if err := c.orderingValidate(subscriptionType); err != nil {
+		return errors.Wrap(err, "Invalid Ordering Settings")
+	}
+	if _, err := c.NackBackoff.nackBackoffValidate(); err != nil {
+		return errors.Wrap(err, "Invalid Nack Backoff Settings")
+	}
+	if _, err := c.Decryption.cryptoFailureActionValidate(); err != nil {
+		return errors.Wrap(err, "Invalid Decryption Settings")
+	}
+	if err := c.Sampling.samplingValidate(); err != nil {
+		return errors.Wrap(err, "Invalid Sampling Settings")
+	}
+	if err := codecValidate(c.Codec); err != nil {
+		return errors.Wrap(err, "Invalid Codec Settings")
+	}
+	return nil
+}
+
+func (s sampling) samplingValidate() error {
+	switch s.Mode {
+	case "":
+	case SamplingProbability, SamplingKeyHash:
+		if s.Probability <= 0 || s.Probability > 1 {
+			return errors.Errorf("Sampling probability must be within (0, 1], got %v", s.Probability)
+		}
+	case SamplingReservoir:
+		if s.ReservoirSize <= 0 {
+			return errors.New("Reservoir sampling requires a positive reservoir_size")
+		}
+	default:
+		return unknownValueError("sampling mode", s.Mode, SamplingProbability, SamplingKeyHash, SamplingReservoir)
+	}
+	return nil
+}
+
+// codecValidate checks the type of the codec and the enum settings of the
+// prometheus codec. The codecs check their other settings when created.
+func codecValidate(cfg *common.Config) error {
+	if cfg == nil {
+		return nil
+	}
+	settings := struct {
+		Type    string `config:"type"`
+		GroupBy string `config:"group_by"`
+		Format  string `config:"format"`
+	}{Type: CodecPlain, GroupBy: PrometheusGroupBySample, Format: PrometheusFormatAuto}
+	if err := cfg.Unpack(&settings); err != nil {
+		return err
+	}
+
+	switch settings.Type {
+	case CodecPlain, CodecCloudEvents, CodecOTLP:
+	case CodecPrometheus:
+		switch settings.GroupBy {
+		case PrometheusGroupBySample, PrometheusGroupByFamily:
+		default:
+			return unknownValueError("prometheus group_by", settings.GroupBy,
+				PrometheusGroupBySample, PrometheusGroupByFamily)
+		}
+		switch settings.Format {
+		case PrometheusFormatAuto, PrometheusFormatPrometheus, PrometheusFormatOpenMetrics:
+		default:
+			return unknownValueError("prometheus format", settings.Format,
+				PrometheusFormatAuto, PrometheusFormatPrometheus, PrometheusFormatOpenMetrics)
+		}
+	default:
+		return unknownValueError("codec type", settings.Type, CodecPlain, CodecCloudEvents, CodecOTLP, CodecPrometheus)
+	}
+	return nil
+}
+
+// unknownValueError reports that value is not one of values of setting, with
+// the closest of them as a hint.
+func unknownValueError(setting, value string, values ...string) error {
+	if hint := closestValue(value, values); hint != "" {
+		return errors.Errorf("Unknown %s: %s, did you mean %s?", setting, value, hint)
+	}
+	return errors.Errorf("Unknown %s: %s, expected one of %s", setting, value, strings.Join(values, ", "))
+}
+
+// closestValue returns the value of values differing from value by case only,
+// or else by an edit distance of at most 2.
+func closestValue(value string, values []string) string {
+	closest, closestDistance := "", 3
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return v
+		}
+		if d := editDistance(strings.ToLower(value), strings.ToLower(v)); d < closestDistance {
+			closest, closestDistance = v, d
+		}
+	}
+	return closest
+}
+
+// editDistance returns the Levenshtein distance of a and b.
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = previous[j-1] + cost
+			if previous[j]+1 < current[j] {
+				current[j] = previous[j] + 1
+			}
+			if current[j-1]+1 < current[j] {
+				current[j] = current[j-1] + 1
+			}
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
@@ -32,5 +32,5 @@ func init() {
 // AssetFieldsYml returns asset data.
 // This is the base64 encoded gzipped contents of fields.yml.
 func AssetFieldsYml() string {
-	return "eJzs/WtzGzmSMAp/71+BVxvxyp6lSqRulnXORDxqSe5WrC8aS57e6fGGBFaBJEZVQDWAEs1+Yv/7CWQCKNRFEiWbbrtHszsekawCEolE3pCX/yC/HL5/e/r2p/8fOZZESENYxg0xM67JhOeMZFyx1OSLAeGGzKkmUyaYooZlZLwgZsbIydE5KZX8F0vN4If/IGOqWUakgO9vmNJcCjJKXiTD/2SfyuSH/yBnOaOakRuuuSEzY0p9sLk55WZWjZNUFpssp9rwdJOlmhhJdDWdMm1IOqNiyuArO/SEszzTyQ8/bJBrtjggLNU/EGK4ydmBfeAHQjKmU8VLw6WAr8gr9w5xbx/8QMgGEbRgB2T9/xheMG1oUa7/QAghObth+QFJpWLwWbHfKq5YdkCMqvArsyjZAcmowY+N+daPqWGbdkwynzEBqGI3TBgiFZ9yYVGY/ADvEXJh8c01PJSF99gno2hqUT1RsqhHGNiJeUrzfEEUKxXTTBgupjCRG7GernfTtKxUysL8p5PoBfyNzKgmQnpocxLQM0DyuKF5xQDoAEwpyyq307hh3WQTrrSB91tgKZYyflNDVfKS5VzUcL13OMf9IhOpCM1zHEEnuE/sEy1Ku+nrW8PR3sZwd2Nr+2K4fzDcPdjeSfZ3t39dj7Y5p2OW694Nxt2UY0vJ8AX+eYnfX7PFXKqsZ6OPKm1kYR/YRJyUlCsd1nBEBRkzUtljYSShWUYKZijhYiJVQe0g9nu3JnI+k1WewVFMpTCUCyKYtluH4AD52v8c5jnugSZUMaKNtIii2kMaADjxCLrKZHrN1BWhIiNX1/v6yqGjg8n/u0bLMucpQLd2QNYmUm6MqVobkDUmbuw3pZJZlcLv/xsjuGBa0ym7A8OGfTI9aHwlFcnl1CEC6MGN5XbfoQN/sk+6nwdEloYX/PdAd5ZObjib2zPBBaHwtP2CqYAVO502qkpNZfGWy6kmc25msjKEiprsGzAMiDQzphz7IClubSpFSg0TEeUbaYEoCCWzqqBiQzGa0XHOiK6KgqoFkdGJi49hUeWGl3lYuybsE9f2yM/Yop6wGHPBMsKFkUSK8HR7I39meS7JL1LlWbRFhk7vOgExpfOpkIpd0rG8YQdkNNza6e7ca66NXY97TwdSN3RKGE1nfpVNGvtnTEJIV1tr/xOTEp0ygZTi2Pph+GKqZFUekK0eOrqYMXwz7JI7Ro65UkLHdpORDU7M3J4ey0CNFXITtxVULCzOqT2FeW7P3YBkzOAfUhE51kzd2O1BcpWWzGbS7pRUxNBrpknBqK4UK+wDbtjwWPt0asJFmlcZIz8yavkArFWTgi4IzbUkqhL2bTev0glINFho8he3VDeknlkmOWY1PwbKtvBTnmtPe4gkVQlhz4lEBFnYovUpN+R8xlTMvWe0LJmlQLtYOKlhqcDZLQKEo8aJlEZIY/fcL/aAnOJ0qdUE5AQXDefWHsRBDV9iSYE4bWTMqEmi83t49gb0Eic5mwtyO07LctMuhacsITVtxNw3k8yjDtguKBqET5BauCZWvhIzU7KazshvFavs+HqhDSs0yfk1I/9FJ9d0QN6zjCN9lEqmTGsupn5T3OO6SmeWS7+WU22onhFcBzkHdDuU4UEEIkcUBnWlPh3jiudZ4vmUm6V9ov1Qc55nKVWePTVPzMknw0RmxbAdsoGaidtf3AtPs05hQbZsNRfhBjAynDYqFj3jwYmiiFjUM8KQltJLJW94xgZW8dAlS/mEpwTfBgWH66CGOUxFHKVgRvHU0kjQO18ke8mQPKNFtrfzfEByPoaf8et/7tGtbbY/2Z9sDye7w+FoTLd3dtgO293J9rOX6Xh/Kx2Phi/SAKJdjyFbw63hxnBrY7hLtrYPRsOD0ZD853A4HJIPF0f/EzA8oVVuLgFHB2RCc80a28fKGSuYovklz5qbx9x2NDYwZsq3suXOxvo5CM8sh5twpvD0c+3OwTM+AQECUkY/b28xt5qIKkC78wo4TZXUdiO0ocqyw3FlyBVSCM+u4DjZg9TdoX26YxE9aSCivfwO7T5q6R8E/82qpw9fd1CXLIdBvgTvzUEvGzMCXIj3EKBbXtZYnv13FQt0Wiewx5ihd3ZQE4pPoTRDDWLKbxionVS41/Bp9/OM5eWkyi0PtBzArTAMbOaSvHL8mHChDRWpU0Nb4kTbiUGmWCJx2hCptSFWUgWcIYzNNRGMZWhDzmc8nXWnCow5lYWdzJpH0bpPJ5Z/eMEBS0WJ4r+SE8MEydnEEFaUZtHdyomUjV20G7WKXbxYlHdsnxdWdgJC8zldaKKN/Tfg1qryeuZJE7fVWVP4rlXGkho1IojcgNX6WSRxN9GY1Y+ABsInjY2vd6xNAI3NL2g6syZdF8XxOB7PjnGvANV/dyKhiewWTHvJMBluqHQr1kJ1QwWtjBSykJUm5yDR71FHDwWh9SuoBJBnh+fP8WA65dIBlkohGBj8p8IwJZghZ0oamUov35+dnj0nSlYgDUvFJvwT06QSGUM5baWvkrkdzHI3qUghFSOCmblU10SWTFEjldVXvY3OZjSf2BcosepKzgjNCi64NvZk3njd2I6VyQIVaWqIczvgIopCigFJc0ZVvqglINgoAVqZ83QBdsGMgcpgF5gsre+IqhgHffQuUZnLoHQ1tsKJBByH0DyXKejGDqLONjl1MXwdCN7tohvo2eH52+ekgsHzRS1xNNo+AfV4Jk4b645Ib7Q72nvZWLBUUyr478Aek64Y6V17S88Da/IyxmbE0rwZTrqWOQFVWBU61lzIXWpNC9fvIthhvs56f5LS0trr10fRWUtz3jL5jupv7rD5Dt2b9lB5uqPaERo33NI8krjfDnfUnI7rgUNbTrEpVRno+FaFl0IPoudRvx9z9IxyKWhOJrmcE8VSa/42PAwXR2duVJRANZgd2OwX9vEIMjhomolg2dlnzv/xlpQ0vWbmmX6ewCzolCgdq+hMhd4/q8I1JvUmqQKdmmkLhzOaPJaMokJTACYh57JgwYypNJqDhqmCrHmXplRrtQNEsYnnSg4U0VqgxiPmfnbmOu7smAVzFcz1CAHu+FmwxNRvcz1FDD86HhwR+QmslKp0ZRHiRq3tZC4seP+qBG4AmM1oCHuHc89gNX6FNJ0hrQKF+7UBJ9d7+oJ/EMfb9PMEjy4cHlTJaJYRzQoqDE+Bx7NPxmlv7BPq5QNUlrxM1EGHM5LccLtc/jurfSB2oUyBpaa5qajbjtMJWchKhTkmNM898XnOb7nmVKrFwD7qlQ9teJ4TJnSlnKbp3MhWQcmYNpY8LEotwiY8zwPjomWpZKk4NSxfPMD+pVmmmNarsp2A2tHZ4WjLTej0nMBmijGfVrLS+QKpGd4JDHNu0aJlwcB9TnKuwb14ejawZjDKU6kItQLkE9HS0klCyD9qzAa9r9aC8BwoOvcwebq/StwXV4iypjYpCDeRsphV6OJFEXiV8PLKgnKVIFhXA5KxkonMqfOoi0tRAwGeF7djtbaU/NsJaqqTfyNZHXmgFobpe1T1aI/Rj9N8rQHIj/YHdLaFCy939tzWI4vsbsn+TgMwJODPcIk5nozjJI2xp0wmKTeLyxUZ9kdW1+7dhTdWt2fO1dcARwrDBRNmVTC9jZwMYbIOfG+lMjNyWDDFU9oDZCWMWlxyLS9Tma0EdTgFOT1/R+wUHQiPDm8Fa1W76UDq3dAjKmjWxRSwu/uN4CmTl6XkQdY072SkmHJTZSh/c2rgQweC9f9L1nK44dt4sZ3sjXb2t4cDspZTs3ZAdnaT3eHuy9E++d/1DpCP43EtH51masPL0egn1NQ9GgbE+ShQe5ITMlVUVDlV3CxigbggqRXMoC5Ggu/Iy7vgAUJK5go1oZRZTu+U5kkupXICYwAejxmvVdJasiB4OSlnC83tH/4CKfXHV0cgvJUmuiWH6zGOfoECBNuUSb/arp9kLLWRYiNLO3ug2JRLscoT9R5muOtAbfzt6Da4VnSkHEy9J+pvFRuzJqJ4eQ8M4YHGLKdnQbfyjA9lwrPTs5sdqyednt3sPW/KhoKmK1jwm8OjfliakwtqkvZie89k/4LXL6ythybL6ZmdyCnwGNDz9vAiWMPkGUumiXPh0Dy22gmaft6707hPCAcgMgCthQk+PzEluaQZGdOcihTO44QrNrf2BxjcSlb2mLbUTbvoUirzMG3TayLaKN6vgsbYsON/L/hAQ/MBSllj1Wf49qNUsK0mHJ09WUYzvH0/ztwe3Eb8luVowxTLLvuUv4fLJmtRzPh0xrSJBve4wDkGAHBZssyDpqux1xnDPr+qL1BQxkTDOQNwIhWE2CTuuSSVxZq14dfiL9o3Oxhs5G5sMmaYKkCSloqlXFsTB9wXFI1OuKaGIKtqnPOU6Goy4Z/CiPDMs5kx5cHmJj6CT1jT5nlCLtTC0qSRaK9/4lZyoXQcL4jmRZkviKHX9f6hkZpTbeDaACON0B4W0hCwteYsz2H1F6+P66vxtVQm1fVaVxRG2GjsfkD7qpwBF/HeAnEHNWVS2SP8W0Vza0uGLcWrJgzpiNSGPPekAjoCYZ9SVpo68gJeq935HbJO4AqHkpIqwyMPFulAAEyC41z2v+531DJqXQqUnsruiZ05paJ2YZEmXQ0iDIRQrM6CxiyX834y7z8TzXMT43ZtPp8njGqTFAs3AhIGngyqzVp0sYVAuFFmVNeRVLBWEJ1hmkFNa7oabyW6Go8ah2/QIOIaPAx5cD4UH+pQj7E2wDMnpGXkPId7D6a47LkttgsIxHZPsICR5SUsY3nu9mg6Z5OJFUY3zM7qCMWt/hm7eH38fIDXgddCzoV3vzbAIo65DLyfG5iAJVlPK9EhSboMsj1vGDa6i7a7BHTwfXNG4Iq3McV6J5Zjj/B9g24qzVSyWpKJfQN4JSIVXjTYyfGWs2DggJOT28QiFeT18eEZxELhio/DUDGtrHdXxwrK88+U9tYQJTCQV7ST7kSWS/bYNN+0a88ubF3XDB5MWnpDeU7Hedd8OszHTBlywoU2zJFMAwfgkf/DCApmXz1F4SJXFpXVjUzyQXa4Ph88Ab7rzTKnxqrHPQSJcC5JkZ/r9sPJukDMqJ6tihIcpoCP2HkwvFApZu2yTpgidQxHECqkWMTx4GhhRKTyQTMX3nQFq+AZXn3AB7u6qyDcUykmuFc0b8xJRdajL0G4TQ9RrSTK7ZYgN0RZz2Yt4ZH5VjjX+cxafOiug6BgLrqLi1gXBdbVXbKSefuO4osR6KFSFCL5gfBgJu+rhGSXZox/C+D1f65d8zEV9BLCbdYGZE0x0H7F9NIOiLHwd+CsDpqQFQIeYib8F7eHTGAqFMGzFK7WYCgwHMRE0ZAeUS8D7z4x7M4b7xB8R24N9J6QN3VgLtdxhCAV5ORoCy0fe5wmzKQzpsEvG41OuNEutr4G0h7FZkpII7af6xB51gTBjasq4YL2FSukCXFqRFZG84xFM7UhQ5gocVHlfkGedET9qvMpN7NXcNB6IAifd5N7B4sdlusaVIewh9yOp3CzsToxtn5RIwjngrSB+C6RZyEVxLGoBcn4ZMJU7B4DzzmHBAgr2C3D2TBMUGEIEzdcSVE04yJr2jr85TxMzrOBv6cE+ifv3v9ETjNM1oD4mKrNLbsa9N7e3osXL/b391++fNmLzhVK2x6EevZHc071HbgMOAw4+jxcoqrYwWbGdZnTRaw4xfYspm1uZOxmWbPWaaI852Zx+XsdWvDFGXU0D7HzWPxgPANwCmBANWvq8OpKb1hrfWPUulpwga+rO2SnPuD59NhLE4DVs7Y2oHxjtLW9s7v3Yv/lkI7TjE2G/RCvkI4DzHFoehfq6M4EvuxGWH8xiN547hoFW9+JRrOVFCzjVdPL6JKcvwpLdXPFzKrv0DaO6Fl4Z0AOf7diu/6mJ1tmseEmWfa0+vV/HR7oMYD3fMuuHTlXc/X97KpYkIev/4ZnS0U2fXaQhUcBTJj4Vcf5vnSuB4TahQ7INC1rh6VUJONTbmguU0ZFV1Oe68ay8LZ2RYtyl7WPZLexkiszdqn5VFCrkDa0XZkxct745Xa192LGNGsnhjasOtAfx1xQtYBJSZhULx+rjllF95haYylzRkUf2n7En8DgpSWo4BwD9B0sFn0uTLRrWRhVsXtsh+juxFBTrSyK8jDLuIuR7mIZKJ0pg9cSzIHSk8BUobnu0kOnVhlO1aI0cqpoOeMpYUpJhfnbnVFvaM6zOFREKmJUpY2fj7xm9IaRSkRhwHgM/av1K/581uOHYedWRRPpjKXXfdmJJ+/fv3t/+eHtxfsP5xcnx5fv3727WHqPKqxEsKKIinMcviGwA+kHflfHofFUSS0nhhxJVcpG/tb9NxkWjWwZCXrH8Vg/N1IxtPrirezZHpLOmldPf7d7SiF0vH79tvcgKRUT7n2s7ADsQcvHwpCNSyEp8kUz93q8IEbKXLvkV/BGQjolS6/R4kM67JDMww4yEOtn4rWf76AnFkRKkwPdMIVXjnRqTdvIGzRjNQ8Vpmlz9B432kD+PWdpGcTUggOYvCPjIDPiL+9ILAkPNpMHXFh/p45HVFnAZS87IAMUSATuXsxFlMhJPEhUFCaSVTOWl5HzE9wHGIkShtbOMSEWVrIaHrSeZSTWKv2T9eJ51lT+eUGnKzVGYqUKJgsxrAiQJTTM6paiDzRDpyuCrKYsBxedtm6dolI1d08flay5o2hN20yDWV39l8a8K9yOetF1+F7QQ5FmV6WI4uikoIJOkflzXRNCR4nCUjkRH4lyWGJOctz6+g5eEj1aF5BBJttIdXLRE1AaqZm1FoDElJ9NjPZKmpzCcqgo+wh9lY2EqIELExuQOgkMPGQu3QWRYpEUVQyhvUlhnlf1rC1Ks7ovwQrZ4CRU53Lc77YUomiCVAptTSSWocyhGgpIxWnRmD/jRh375COQOaK5Yn3bhB4NTWR6moxz5BqFtCBMIoztTXkXgdOMKgV444IrcJsA/mPR/5zHQlillg2145vMpGokgi2VThW0Blc17AulU4VhIa3qKZ3qKZ3q3yOdKj6APqDXlQJs78uXzqmKRcRTYtVTYtWXAekpsaoL41Ni1VNi1XeUWBXLpG8iuyoCaGUpVry0s8VLvyeviDUSikrFb6hh5PjNr8/7UorgKIBx9U1lVUEaT+T5cisFf1iNGyPJeAGYOGZQt/HLr3AVeVIP0K2+XrLUrbT8tTKmso7a95Q29ZQ29ZQ29ZQ29ZQ29ZQ29ZQ29ZQ2detkT2lTX4SgntKmntKmntKmntKmntKmGoFBWY7yz1/kv34NH+/uLLJM8CiEzuV8rKjiTJNsIWiBTguPUEkz37zF9YkAr6b7+Q0VC1epOe4z4cqpSrKmZxRqkTTmWXM9PkJOCBgWXiEfV6GpF2jizOB40E4rskYmMs/lnIvpgYfmL+QYF7CRc3Ht5luQZ1dJludXz13xZ++QkYL8wkUm57p+/xzBfYdBhs+uEi373vsg+KcNUCo7a+/A0gBjkfNx34AFTd+dL38L3owwTr6jEN4W5E8Rvd9+RG97y/48Ab6tlT3F+64q3reF6Kfw31vwZFXgpMh2V8QQ3xzv4hQPgkfP6GhFAJ3/fDh6HERbu3urg2lrd+9xUO2625KVQLU72noYVCvi0A3z3Sk3bbFZl5kvaKn9FVPM06FjqxQk4/q6e2yumRIs395KvOa7TM4bNauyU19VeY4Q20k6a28Bf3Tw0SmWH7HvyvbWx0ctiCVUpTNuWBrSxVYQ53z2gcTTEEPVlJngsrDL7izx097OA1ZhRRQVixUt4DTUssRpOmQ28NmJGYEeiUXJc7YBSQdfVJ0oWRIBturVtmJlHrHYMxoHDt2/ODv8ZW/Xoy+/uptmC6QHrmwv2U5e7g2HyejFzmj3AUvkRblKd9chOrlCkkcplXHFJM5O8KSRQ0EcFGRjA2744DESwUXsL2mzV++EiylTpeLCpYRy1/CT0ImBVh2IMRfR7QtNWM0Me33UGpGiQgdrSZOZ1YFkmlZKWRUTg4Gx/ZZrPwl9m4yiwdoC6DEBuKlNKYEP07qb9nw+TyZcMbYARrE5zuV008wUo2bDmpyWN21uDUc7m8PRplE0veZiulHQfE4V20DkbNgJuZgmM1PkXWkyTPf2h9vpDnu5tTWyf2Qp3X25t01ptr2XZZMHEIjvYXkJh+ELBMAFiv8crnV+dnj69iI5+e+TByzFtbRd9Ul203zO+tYCW/746fDEe23g73fB/4Kidu1uBASHmmh0Sjt+ew4f73CovWp0/LETHr89J79VDA6atbuo0HMWNdO2v7tCRM7+YhzOXOiaU7cx82MtSKm4BNfZlGEfUTesG/TZVSY0FKA4gOevnru2tgs/STw63Ar5FBx0Z9cNht2IOG3I6tF4OUkbF/8OBrQS50yxeu9QTeAax+lCia9ePX9IjkdjxUtnk7VYrSAUnLdRihAV7g28e6XpzM1FtOtipZiplIhuFXwPeldJOtJyGYErpmu2cHip0yv8BiCeNfPt0BvZI+MFOTk6r8Mb3mNLLhwLeC5wythxVdTLwR/95ILM7VsnR+du+Hbgqd1LS2NRM1vsNgm/NFO67HOelsmhIQUXvKiKgfsyjOsXVVTaNBpXX9lZrixwkGTUWQbX9QXlwBoIYUiI6UhBQHKo4g19ozUppdZ8jJd+GXSYsnoerd17ztHt00T6AaWapNiJ1KVvrfeRXZLmdGUJRlgzhGL8ZtgQn9qXIcVA52AXdYiN2joc8fRtL+hRMbLPio0DqCJWh8E+PkKweQgYxYpfPkwaXy2ZyLS/6IRiNsB9/NLjAf0aO2J7NEz8//eudtXFXeI4LyPjqiAt0EmJ7cl0s7ErdY46OSFHbw/fnFjCHzOLLPt+fmO1qYgJra9rcoU3kzUrMVFamRS+Ma5UiulSWhQHr3M0CJy/hJwGniSk8WEq7TGdPkOuoK2ez2G6smKERbl50bZAzNYtYXp+a4xZJmDjtpjVC3+9BuHEN+C+tywaFgwY6N0F7xCl6Szm4GwCDKiR/8a1pX+WJeRXpqSvVVOAQ3HmLviQV9YIHNdYwyl68pD6CXWF9aIuZnWtqEfyEqDNpjuL0Yypy0lOp6u7m/E3q1skZ8ZaKJYd4swEZm5UUiqx12xdVOiAHB4OyMXRgLw/HpD3hwNyeDwgR8cDcvyuxw37z7X3x2sDsvb+0F+63lZN4ItujV0Txm/HV/hUw2VG5rWLUsmpogWSHrrOTETBGNvJlGvaFw0EaeElrxMkkS3oHot4azQaNdYty56EkS++eHc/KgVe4qCihPUm3CXJNRcQXI16aEM1JaRgWtMpS+KgP67hTtjhrm7nicG6OAyquoAZuLqOx7wVR3/7cPL+Hw0cBZ741XQC15jVyQk0L+4V/w3W/SXkP4i8FgixZAvO3Fa9UCHFBrggoGN6OqOKpsYaDs8waHh7CzKeLT7IaGvveRyDK3XjjZpZB4MGG+UyndLSnh2qGRkNQUZMYY6Px8fHz2uF+keaXhOdUz1zBtpvlYQs0zCyGyohF3SsBySlSnE6Zc4K0Kht5jzKe54wlsUjpFLcMOUSQT6aAfmo8K2PAuiMuRu1h0nRsJ+PSXx4tLrTlxDxlATxLSVBBLr4ytkQvOEMcCu8K4Whwyy+o6D9+Xzej/SnCH1kgU8R+g+L0K8J6OuYAc4auluDODw8bObBe5P08nOSRg87Hrc8J6dnVmFjUFnzKvZUXLVcCf7HK++5c7TDJxOeVjk4hCrNBmTMUlrp4E2+oYozs/AmUEypBTXamn52KAdWQk4+GeU7sgN8UX0XD6iZMQVWP3gyI+Rc1bopvWYwuPdOYfe8jH2ybxeWSuKhUS/Al+B3RjWHKMkwYt37HNUVq8lOZE/t7/V/rkXOEWvX1B9HbQPH67tfQ933c/Vnir99B3FoDehWeCjW41MRvPE+XCgbOAxbjRQIrym2oLd8XfUu8uZDGNWU3zANXeWje4BGm3l4LFUsDtPLhA6jTBC2tkN/WShqALx33vnuG0C05pfClzcqmXLrfyZL9KLmCzuEljJIFGeT4bF4npBDkUEzgVSK2jztVOKyh+r2WwXvl7fWmmMGHfoODtxQpDZt3NecHN13X/OGGboRO519kUPnVV6+Pm7vhXcUSKPYbxVXLIN6oV8guubk6DzcfoMAC/i1i9HEyIRcsVQn7qErTJfxYNTcD1Qi4DmVNljmF66a89yRUERpv8yYwD2DDUyV1JGmxkXGU6bJxoZzgrqLCAuQxafO+XRm8r6OCdFq4P0osDtncPdt2FS5m2aa/cuC6hPS0xkraAv/pBFy30M6o2SYDGPKUUo26mmehC+WDp+nIrpVc9G+QL4L8F4EPH7QDFk7KA74nLvOKUsGdbRyhv05LJo9I4CMlpRa8TNHsRO8Fbj33GiWT6KUXIGjP+BObUW1QQCZ6NppXRcggHd62laUIOMDl3ogcO6ke8CIUlx6FutdUo2BtaHp9aVVK76n3MELDPBNoU5iysIdDmDOEmWZw50e+9RqYbuk0L7LzxTL5rBbg9i6cLUEqdaN2AHMpw81IqKj/y96Q5OcimnytsrzMwm3Byf+8Zgn3HgW5XlC+OJunuDOY199XQge/mRuSTTIpbc7sBSv4mnjbAcWcmgfJVBuyBUZ1J3aj61ql1BicIbnLvCaWuV/LQOnAUHgKhbUOUzUhKstMJnEtB4j9DCwE9WLcOP5oahPDbLUBOlN2K4I+yDV1TidJxwtk1CQxI3p76XBiItLcQywSqIfpO5ATcbMzK2+TuOSk7RZnBIn44IbDgHcdqtyqe3aDv1O3I9uqzeFAqRwoS0qrHGWk4JRXSlWYMspkd2C2egxCBo39JoFGo7RHJNHjeOCFRLCQ5i2w/jhshrTrhToDQ88yLAC3O+VYgk5Z7jnV5isZgXXFS6bG9f3AA6/D4WAhMtwwx6OcBwp4CCFQp/GGtwNobxc66clipw9WvfH0YPC768MXLZe010RynthaF4criCit8gpVMQGEqhVyhkVHq8pNWwqQY/344fNtQzjChCyQbPsakCu3LnZgHPD4KsJz9kGqu3ZFd74+HuPBtcHfT0KJnERhTlQWF/DqEoztVFSrS0yNzAmqKkjONBXsx2YTAUHaUIm1qyxuuERzulrUGKUFZrKoHVSgztSe7XA+HCuKbc1diAPPJlxpqhKZ3FMentvanUOt3ttzKdkXEGlpDULXzQiZ7rpHos07Nww5bhda4oDt7NXZOGERVC7sZGdc1e5x8KYkILDzcLdeKGmzDXyrHwRN8FzM9pNufJhmdy13qJxlTpdjT1YbaoP43uzzM0LzjCa53JuIbS2YtrcKCd33JIinxo1Vt+ArQn2Q4TJrqlXmZlV3aJyh7frrF/ONXDarHAMGmwIZXOVp/kE7WXIrowwFxUJ9qFUlWZBaGRMN1qfOYVRk0pEFYMHRLEpVVke7z5wf3iaWD2msn9IRezywC4D+wgFjbxhCqQMRAx7lclrcDzeEuYjJlHPIafH3W3Y2dvZbyIfOdA9vCCrnQtN/LrTgIN0eh+yTZCPc18x2hVMppYgVZScpRgF3mapcwp7IpX9DF6RkpdQQPtWms641SFSV/bs/0AZZkOLEtkGNfFXdWVGB2sDfwAtQ7ehL1QeXT7nHZFyKkhhRbLmpkLjduBCAc1ckjCtO2hj1mNCI+v3H9M4+KQR+J3SPIXkNFdDLYcoGFSMYu+RiytwcZBI4jWTiNUW2BZ4FZCOexKyvzPCjeMSLUgKKbiRddxdPcT6Opi5fsfsR9/izkhyzVhJqhLvA+Cl+HA1sWrNZIS0iUcrWvHEpTQfxDtbX9ZGhRtiX+rWcLS3Mdzd2Nq+GO4fDHcPtneS/d0Xvza9qBk1VLP7at99fjkUnKYVSCYaGME7ErjWxsh7q37IqGmcNSGk8uIGKzPStCFncjkdODsvl9Png3jyIEWMdDrOoi4BHp3XVBZR4TPsrVqDDZsOWQcF8GzI6xfSBE8VDG/1nsbcYOqFoLZCZlVekz4WuMHEf9R6KMmkiWrPx8P0CJuSpjOWRLgI21upZert9tQ2bL3JRVmZS/+joEK6wDVv/1UmfoDqNzzPee8zeFMGNDLqJZxjN3XDJ0bgTi9M26Qk5FOIdXvm8TOzZpNi7jbR1Ld3jTjEPl7kGQ3MLjJvCtg95Z3SO0wsE2p1m0ipQe1Ik7YgQXqzgtN/79WqALiVNXD5J8dgLraavawwCehnqmfkWcnUjJbaHj5t7DdR/s5zuMWjcyfJDDRLoHjBFPl4Cim0UXb54DIAR6rVHDse0dBms++vwx+Pjr+al+702K7Gm1p3lE7ZpzuT3eEwa0ImpqyboL+8TnIRZALQReCqVCl+4wMmGdSCVjR38Z9Gqo6GAbqFr10CysBVLXBiXbxFl15dyBchnypxnLKWxLmWndEb2lQ8QcGoMHEOPGbXWHkdNaghQYEims57beBT4YxKe7rQ6LdmmNZVYTUGIYldG1g7g6ApONnrr5pmSgqZy2mjgIwVNfLa3+9zfdDAFfl/24urv/HbfbWUzN5NRsPRr0tn2l/zNjP6xuxcH431KEMXnTt4Q2gH2vCjtH2TkDbi1Yb4Z9NpZ+K5LobSQOdJ9ONFF2vG9QYIF5y136TXgnbhvN5qQX6Havu04npGaM6U8YoMnIWGd6wVQIBCqzlaS0fFNZIZVjDVGH4KEDRStSIBR2ZUZDlECc7YAq6+5tZUFiY6porZNYOzsv4S1QxAiJJ5vWpuYBQ46dArDUKptLHEMJ8xyBELAejYvx4u7gxc802rnKoQGV+bjsoqVz0qT94umtXQqVamyOIsUU4IxCrDWtqaorvlduYDGCjIq6oSE2QdWUEdXWsiw9BoUeTVFDSBrielvmancBKE155RHz4EVRDk7/OBPzc48lUrkKxhCtZXEeAGtM/fpmc2sO55/yrw/t4ydfbJBOeBJWdhuAqn74Mj/zu0hluMaKuxQ1ALQ+0uk+ll1No349pqJhk4RrFWHpizkLbLsprorfbvAnEgptcozm68LX11iXvTw+rPWUlGL8lw/2Br72A0RE/30cmrg+H//z9GWzv/zzlLK7sA/EQwcRg6pzGF340S9+ho6P6otUDLC3QF5xSrPGsjy5Jl/gX8X63Sv46Gif2/Ecm0+etWMkq2ki1dmr+Otra3guqfsU99wkZWxtpK37S8sRbVY8WNW9+VD7TLmIBI65iZoRCJ/K7UIx6ud2ozkvLcKjLBx1Iy5eOog0iBPhvow8H0YtdTra3VvJXG5RygxufTbaM+aCTy/WcNryUyEEzRaslCy759TaCI4dfirIWYgZUFzomHYpLXbpJogRHoh1Y6iAC/100phr2BXChl5U048iysDT+7PDEU2WHQOvYWtTS3RjD/64J7dR5rKHsUDHIUsXb0SETqEFSFvFreQPGXeIOX2tabOHLEbWwclfWqUkBPNVqEy211zB68aZA7axVerWXqLv1wH27RQkyD4dWla+zgUXfC1s2tpQw/q5nF3vgDq2RcNbqcU7EIWgzYpRzS/jxgJJMMWW1Br+vd0UzoHuni0NpgMStu+L5+HkLQ+s4Z+pXhVKHE9mGy5wvtnFFdN/RrOY3crgXqTw1ZW8e9eVvNi5meVj5Ey4mZU8XuSqNyhwU0gPOFLqzCNjOmzJ6DaxlOlq7GrnucG7hd4zGM+Ayr+gzqsjEbbokbXixtHFbWmhLT57cVOWpso2JUr6y4yvp7GJ3MZ4s4ssxf9neZVNcD23NVakcDvEHDghS0U8daLUYdgYc72MZtahj3F4h7cmcI375q8hQ3ZOAf7o7GvYJ4u+pp0oSLdcXt7OLD1XurmjSZs7E9Rp98YLpowRMNaU9vxgR3YkcxiPGutfogG1rgBTba2GcEEonyapzL9JplRHPDrnqI5gLi6IEjUUEqwXxqZFP/vdcAhpKqkS9vBcTmJiAf3r8mORfXPkL/7qqcni7bVOdHwTKwEHDA0ziAQfpOGGEEchiZj4Og+DSqO0QW8wHYSlZYK4YStpACrvZA7IbrQeyv2dkZX9DGdaaMciQ2YY7N/xgOwfG29BZxfX2pIz3xNs1xkkvaG7H2nutrAiOAsaS4VBwD5dvMUDt+RbTMK/D+RJl0HzRzV0mwNLjMcRdfqA/Y05vcAvulkKpYgshuXcT6W3BM8d9ZBsPes6ABRsTolMJ9aFjE0NLNaDjsceYVlLtivK5k+EJWsO/N6xUnFZCbQAqvjgDSzds0O8TcOec0s/Qk6mUg1lyYLWhKWDy45TDXlq8sd0Qf1tvq3A3s+6PeItYhPrD1KAQbI/z+GgouYnTnUnwAd4L0ullwgH2iqSFSZS5yIjheotvx+G48HOvgvA3XIh1s3bCoHeAXaU+F2bAY6hUmaJ6fhtC86/byl1BYIBgMYcS4AEGU9oJP+UsWH2xAo+B7z5104m7cqtIL7ygYKOwEhI65WTmL+lJrE+vejjJjvxuoA1bb6q0C4vS8sJ4xs2iG0mZXuZwmGn5P/O9JKjN2lXjm67+uRWzs2q5Dr7ESj5uio6w0rkiRq/n2avXRPD0+f95qfe3eCCq4I2vCjSZyLsKMmFdhZXydMBHGTWWJIVi3LzeK2QkL7kqRF02aNnSp1nZ3X5rhjdy912YuCC2+OIsoAi/Q6iCNW27O7Dn9vW4VvYKcnrsN1caS7IGoGYfd4bAg9Gu5UFgHc1MfyRWjmdfLnLD2hF7ffkRiEg+gJw4ssDfnumHVpykrMTs+TOrT1KCoBbXHXwow/06P3eRrJ5WSJds8LLRhKqPFWpQ5T8djxW7QzvWPn1+sPUezk/z880FR1MyE09w/tTHcPRgO15632Gg3tvsb81SZGVePDACEWLmmE6oV17amq/EGRgKugaQfIElhVF0kO0itzHeiC5E8kacPCBN2v3UULuj4aga37TJyfuGiIIW1VHZLQel0jh2fHegaJN7ir11pIJ/zLS1K1lZVKrVMwZ/H9YhpmQ8Cxobagl4jk64jdWWP8A3Thk/96ppeniUsC4GFMd3QmJDDxUbGSjPrjI4iyd2A1Q4fvNwVcUqFSz0UYHySMqcpu9U+ucUuqY/8Z9knxaLHQoEpNne3Xowylo03Jrvj4cbO1mh/Y//FZLixQ9Od/RdDur0/YXdbL54eJtxdMbkMi1f+8x0JFodYYrkVjQ/FXjq3k5DooMnY6kXNUEWXMGB/hchNHyJvx3YL9/v/CmpQu+pwTu2KvIZwwOG+we+Qz0Hwn6nINqWqF0saMVcDV9UkuKjHC5zy1N+6kDf1ndc/X52++R9fLVPX2QZWyPKU6ecJvuyST5zDrxWRD54SyFhnGWKztR5/HKOYBOfVfFDUPkYCfoZisv6auhgFF7KQYyl9P3SvE997e+ut1Bg8CGVhwQuFDuee4CNqjOLjyqysVVBd0QrxHuaLxX/40vXSBPZ8Q9XC0kZoBEZ+ZgqDJKGiDvs0o5UGTznUQZATJ1ua3NpyheAN8tkc7nhCge8bNoBrA8hHzwZ16zUro6ClSXxhxz6xtDJsQGY8y5gYQDAu/itFvhg4Djkgc8VNj5d6/Z9r/tm1AVnDp+/tePTU4+apx4156nFDnnrcPPW4+XP2uOlNLHmY7gB6EIwDyiCUDF9SXYB4TiS2xvtNZSGNgie/lHZTKwRO56IY3wV5eP36Dv4WyhrDMG4DUXOoSvDjXBV2qitn8nF7VpgmV7CK6NrKpZpgFhGWXQ9ePfvowFqaaRjOW5Me7rh4ewtfjazTLy3ijmFwFwahWxfD5rYOJTqjTRC9srMElHflrdc9ZkOthmC25BJYVFx5N862zhS/iQJuoOqpcy9EJn9nJZszWbBNmnsMhxXZ4S5xmM9FfS8RHytQObE66x2rbToggAErlrMbGnmU62aLvTGbUZJOWTJl7Vlk9A03HYjJPDj+4xq2y3IfQM0KG5ggbwqzDAj7ZIH34i5nFP7O5B1hSgHJoB80auaFga2J6cx3Q1Uy/f35ADDf4PmY4CBi9IZ7+Gdr09/XBoDfNRxhree2uXT+Lo++6cqq5Z4pXlgBhR2ST4/Js59Oj5/fecTXR8PhqMmIart11RC221n0tKttH9iv2t3tD2rh9gf2afsDm7HVGSqrS1k+tWPXvmvPUZAb10zDu7jaZ2Vrd297f7t5WgpesMsVFmB7c/rmBLMLvDT0OdEALRirzX5wimijGIXQq/HCRC6OSkNhkqhpEKeCJlJNN/EuHtKiNwuWcboBHur47+TTzBT5P08P3x7WImky4SmnOfqz/2fgRJyv5pdgUayeDEurF5Vgj4xdtcwwJib9hoyIaOk+/3RZQVWsjpLeWEKK0c4Fkak1JwJ10d4CO+vDvZ1hi4Q+U1PuUZSDhkshwB5MmuYxW2Hjh7ftFoWofISqVrVg91kwaI45pbCDMi+k24JUzsXKgjXRrW0nWAfPjoJk2Pvl05INFL+V6lXQlBH6MUb20aC1YUGP6lHKG7pSFim7D1PKN2/b46f+jU/9G29f7VP/xqf+jU/9G5/6Nz71b3xA/8YoMo7//sC42B4/jR3EHl8wNSJKfxf7sFAZgLpsLoCIa7JmP/aUfx/tbe/vNABFcXz5nSlXF6hEgHoFMUiLAkJkHlUH9NFKLBhQz5DaMq4gsMNB8rxDZSEKI8QkrbS1k1WswU/1AfxUqg6hj2rRPjtvObFQL1/GlfVpd/gyoTmcQsNvkFmt6hr5tYsrcBefJJrXRSo8Oz98+zxB+wgM5hC20HdVSyszw9B86MQU3SXBlo4r48KX6oJbrWL5x2/PSbxiQp5Bfrw7H/o5+odZQXlev9dF7F8SllNteJqkcuk7KsA917piKkE4lxUVn4N8F9AFjPbZ0VugGwsE3MZHKAzI7azWVZoE3xj5mU9n5FDrSlGRMnIOVVHJ0eHjkFAJs9TdyechAGYhz46eYx299vo+nD8G+KigBMu+hMw/jgd0+3X8mP06+uuH8wF591e/b6ciHZB3H/7aag41IEdv/3rH3oYj8ll7nMuU5p38iS++yX4az1deP++oQ5YMLEf4O2fzx6xEqikVLsB1xauJp9Lk2bvPOLSnIv3cxdL8shJ8VSph35ppTuyMdukfHrH2vi5oD1w/VB6+lOoS1NHVJTQGEQmVjiH7DOcLAvJiQM5BRTnrkPQRzflEKsHpg5YopLkE82+JNd3mYb3oVKKOtwYqfICWDMak0DyDjm0QvtLZrq3h1nBj+GJjtEeG2wej3YPtl/85HB4Mhw9eFXZlXeWyMElliSWNXm4M92FJo4Od4cHW7iOWhC2pLq/Z4pLmU0vrs2VyHh9Dh4d+/OA68KnuWFcB+2dds+5he3/+MLkQLSqt1M2qDhb0v7Tj44J8ke48tw+k7qd6WSQgGCMPgvCDLnUeN/4OpoMEwbUpd7dGj8UE+1RKUefKPcb2PHFDhA3MGDifW9sXgjOXWNXe7u72C4/1dhmaR6zyM61rSBy1trWzfKLd0yVN0ebmpquubw1dGeJlYdZMcZpfYnLqigjUFS/Eqeo8WF3V1Nov7aDCQEivTBdRCbBJXGYT9ricUZdoOmg2q0ZXng/gl2A65dAuR2R1uEwYuu6h2sHu7u6rH398efTi+OTHV8OX+8OXx6Oto6PDh3GFEHK4ck532uzp0ghkDnGPETf4hdX1ZvG+uPaFgIieQMEcLshPkrymYkqOIMaZ5HysqFpgjwTv15xyM6vG4NKcypyK6eZUbo5zOd6cylEy2tnUKt3EIOlNixj4J5nK/3i9vf1i4/X27nYH/xiysPFQPuyM8j/GEtXBFPVgtFelZ1SxLJnmckzzoM0JtvTVRGuRf4Sl+ZmGpgf+a1qandh+57rB4li3mJrnF3+tVdEBef3XcyrIK2tEcp3KyBQdWHMkAcPzy+7vN2NlNlb+qKX80WbmbQeysYWfvbJvwKZsLfRha/kz24fulnW16s/f66tcO6nTRzpUt3035CFCk+Fhc3mhP7mPd6SF/sRk3IkvpUotsKQoJjnROuAKQpItrFGblJBb0cz9BeV6ymR4Jc6eCl2LsTA0FsBg6QwUwbq6mYXs9MxrdVK5+1y1oauyzHnIlViqQR83i1XlGx15Rti9eZTCKEabRcgwl5qJleU/vW3kPbnJut1ipTIzcohttloAgvS+5Fr2NLX9MihzCsLp+bv+XrZHh70grWoHHTi9m3hEBW1lO3iqvgeUKZOXpYyjSGKGJsWUG+jvJjKSUwMfujcs/5es5VKsHZCNF9vJ3mhnf3s4IGs5NWsHZGc32R3uvhztk/9t3m59Ad1o/YM9aj5VvBVOQwMKBj7PBYsryAmZKiqqnKo4ZdHM2MKyFoZMJboLPopbIESX4Fy5As1QYQf7u5BJLqVyJuIgWHndqnQIXk7K2UJjIU7Q2gbABlBgNPMDokqJ4DXgwtqZsgAuF7Gx7o30WGojxUaWNvCv2NQKjhWeoPcww10HaONvR30wregIOXh6T9DfKjZm6Q99eQReToUvbpdUFzPmkgOiBpE9ZYzgGV0nbbeSZeJyRsu3KW506f/igU+NFjUhA8qEBUNVsIK5YmJxydZGjUVBXh8fnllJeYiVX+tsKoQ/7ttyW0OKL+3X6Wkdi4vCMvguz30zZOc/ho99rpQEgJIfehqUOPr82X++p4HpDHuNAHnWFFnXGoPfg08l9LPkqh0OBnV6gl9FeZeBfZ/5nkNvjncHkCDyHOi8VMxx64QcZpkHYxJKXWBImxtivICa1Cql2gfzNoFDZky9r8dV0YfagJqVVFEjlee4VDeq6jzTgl5j2ZQBwfqHM7p9uTvaev4Ale1rp/J8/SyePyaB52vm7oTzJHWjI/DP/vOd9WqgOEy7Xo0rIA0hcZXB5g3aUBEVzTs5Ood3k7/4Q3Br0e1ufReYFMr4upuv2L6JqvlKhYbLfS1oYa0upqcZGT+jKptTxQbkhitT0ZwUNJ1xAfE5Mr3GK0NDuQAFyB7F/6rGTAkGFU5kxh7UC/bWWPkvIv/ftao4N+brBsjv713u7fxREhZloZxEe+dJzYvZ22RsnWiLumcaq692kPV1fZv0DSNKRd4y8+Ppu/OGXIaZXnNRfeoZuwY6mimMCHLfFynvyd999/bi3fm7gJl7nB9TJpNvyGAGcL51oxmB/OYM5xisb8R4tiB98wa0BfLJiP5jjWi7B9+iIR3B9Uca003taglI7iTUn90YsYRp9Butu6KHSrdzX3L5ykNwBYaKPY+KmUoJ7a08kK9OvbnHAP0y9VCd9YlyPa7/cqgDvnwDJprP6UKTCl4ZQMlHVzE6OBEKRgUXUyhg7rr3MnHDlYTE6LhXR+gkgHE3CiNRXNuoqzGjBhjLVRsL5T1YCA80213C+sp2iHawoWi6AuT+7DbztllXdCoaSlyXPuH2wgW7A2VGVBlR4wfBP/mC8I4hQmuq3yqaQzJ0GDPSzUDdp8ha3XVIHZ1SaaYSV83dGskkYynPoEGTVS+BlGomLu3zrc2XOpnQguerurZ9d05wfPLMX64olkH53YyNORUDMlGMjXU2IHNUb7sJIPhkB+4qX6Y07beSeNMxU3B3m1nNIbsSEwBvUVVpavH6Rv6L3rA2VqLeMyvYzfYacLYANpjJis5d4f0O5DvJTjLcGI22NsCW5mkb+scpPt/ansYVBBxqbtvE/25jwHsjv9YO+vnc+bT6mtQDUo0rYaq7ziRVc945k6tNQu4AvyzdjYbJaCcZNaBdWTl013S1JSashX2UyyoLxrK34+umV05LwVQqaKx7ZbaSgmW8Kq6gucFN0epu1rDUg89mAJ7b2nXgk4rjq/Barwgj9ukXrWrg5ZJlQW4LID3H5uW1ZhaKPaMbvLlt21u7zemtvPujLkQgL3CV9yGwOsh7+0zbz5p+zQS6pDuRFZ+PjGT7w/itXdi6BrXJi88JoTeU53TcUwzjMB8zZcgJF9qwFrMCHODty5/3hi1a5Dd92RbB+bXv3VpArLLIhMMU8BG48YL2BgpDmhq8eQI6PzIcQaiQYlHw3yMDAVEYPn4IDa6uYBU8u7KUgh+8dYz2SSrFBPeqXVhaZK7PbxjWl7bqIaqVmM5dUnK7BVN2gXi45+EP41znM6l8qQUoxV171OvFNYo4jdvtveE5JfOV5YqHOvdAeDCT9w5CIa9mM7EWwOv/XLvmYyroJc0KLtYGZE2xUiqrrl3aAe+tEB98SsY0IjR+vrg4g8+339i98vfeITjQvhR6G0GbbHQXVSr3bVw0wx5uJqIZux0q9yt17R+Xj9XwL4xltkjiMncP7PAWv9oko7iuRQtMArO292V//8XtILqKbN+RBnDhvCa4wXeu/GeW55LMpXJd/zsYWMH+XEgs3n3HLj2zwAK3nTFqzYCubTXa2e7ftIKZmVyVIF9voBSnimTMmeIS+s2dHJ2TUbKXDF2xxzyXc2uTTSueQUGCOQ1dTLKDeoA12Lu6gxIpKg095aP+iUaG2BDse/NbxdTCmnRrDT+qnNRgoCstzA43CqViruEOS2nlDn/obembbTcKPMJ6fV163zEShG9BofW5YdAuNiHkXWMgX367oCJrNCHlAoDcSobJsHPx8NPJxYCcvTu3/36w/8jzi/49X3Etz/U33FX+8JQKBNpmgWFVF3V6G2xgT11+qrE3j7dJoR1Nl1cNIpZg/PNXR/jCxgVU5MEzkpAjWZRUeTdZEYNMw6BRHxwSz7a+rkk8rBvVm94zlpdut90uwzSK0bhdDyEF16A9TaHOcppzJkxPdwFe0CnbnPKlq1d5HEPnXrVUuP9d1t97N0zdNyo+2B1m49PixrmcNjpHtWDUpRSafXXRhtMuK9tiIL9/4XbX2m+Xbh4HX1u8OWgfJ98c0H80s3NgfDluF23hF2R3btQefoe/PIbhNbhbGBU6gaovw+UccrErS8+l+uf3P26eG9e7pvdufWfYjAFbreMa4LrtJmAEjum60rhhakJdNpszgU4bX94dqB4GiIPVfUECxVKpMsLFVDGNQcAM/2zOSxquAaiTh9Yc3j1S4ZvEqnZDXqJkBZV2c0nt4citUqaeh1HrY/IpHJMw1oyKLLfESENHvlQKERSvU/c66m9uTOr7aIZhahQgcH4szYSWyrURL6kgdkXP8UzHcCQOPz2o6AnnXN48pDmnqzLeA4ngLHjRWu9Y7YIb9ATL+N2rVVffYtolpsJ1gkUlh6orAyIr4/5QJCt+B49GCh4lD4agRd9VjHtxWa6xMrdlja/T4zayGuRdY+v87Zuzzjkh5PS4R8ItXWVmhf7O03gv2O0U0a1tbmb3wF+no01jPvXafbwjtvq4E/YcGjr7BnUFS2dUcF2QqGsdFL+10EeJnsz+WodaW0ZX79a94dad6dy4nldiPyvfCjTMH/nAmi567A0eJsJO6X5MiM6IW4j/5aqxEP9W3WKgp+d8a4VWjbCLYFk8/l9CP9lxZYii7uLP9539C3iGuXA3gtZARfQ9IKAbKqY+ru5pq1Zru9K7RSBU02z01i0YBLy3giXCAbyrFPxSJeDroxy3j59TLdbXDTTGxIB7GuAbkExiT3lHZXc26N28oWozl9PNSSWgkK5O/MFZgkPERaC/6E11cGPYVYX4T78N7Y7KDjfNDl2IKefs0Q5BbigFllFlDYbQaN+06jqB1BWuR9JUQqICkjEMgpfecA6iZv6wK3hQFvbtWrH2ffzLysSnJ5xdy2U8MASagqCC4Jq/+5+eR8s+hx7auJPIYq7mVImrAbliStn/4fBPrSPQvKf7P7TZbG6rPbnqnn296/RcNCNu3YBOQkODN+xlgrpT3RC0AuYRH6B4lDSn2sePccEN9565MAPIfN9UmaSVNrLoD1SSauqLtGK58GQspdFG0TL50f/VQAq66KCgfZJzsYxktAK5RmQHQ3YUX9opLtfr7sO8ieXIC4KucPHOWxg79FpHo7Xana1bl7JCIb/eJoMvtbrwfd3EzDT6wVn2F5JgfRvLmIljZCLccKYG36sn63/FjgvHP4iYnrMUSCf5F72hvUivRLrC4i0dlLvpXP/Hmcw6WL6HdrgvxNJcCF0J3/esoOETt7AVTEO4MFwF+1BrH6caPxG2Eas9El3m3GBGnCFVaZl46GxXUmXicP1TjJVV0B8Ipf6VG9bfzCHy4qhaKuzuQfmzDEaszb+acN0og5hOG8vwix10FpS4MN4wJvRWoLmV/QuirQzAjkWpM4go1gHBKC0mUglaiVREsDnwHKtsF/KGNUkeur5WZRvktsOpccag7B/LYFcymV66AHErijKu6ThnGdHSYj6lIBrHDK5N4oDisY9GBE+WY96KGcVZqINydYlsoufEnbOSjF6S4f7B1t7BaIjpGRC+9WZBalWmU6AwJH6CfF3iNGLP/tvOnBPTocVqrIQMfAfdoLyh2C+4iZncDadumISc5YxqRjRj5P2rI012d7Z27BZuj/Z2kh74kwlNec7NIlmF72o9WqGrF0j8hB29rB34FNZ3mKZSoYYso1VZ2rHLGsTVCWtfBhVejJIxM3PGBBmGIe27W9tdotjavhNHK5R5EaasirmBLtilkdVaBxDzi761lIpLtVxJs4dtdWub/Txdgn7kFrN6SK7JPvlLjZz/DFpu0uQ5ofylfV8hX2efSpa6iIrAih31BEKBmUcvRz09M7Z3+9AaAHj4Mbr3xATtfukT07D5nKIEZU2hcU3EMGIzp66r0J645jSApbZ39PT4/PkgtmisSdIB3p3MqbSId4a7//EquRN0ayCB2PAGkgVWGy5SE9lh1lCyUkCWaLFE/WVTWaJzqGUU9YLS2fJenhA2fNV68B9NDGHCZubNUkQADvFbKCAyiP/AzY+g6Oz7ibNvg1sTXe6xc/Bt9NU9xUy8w75ZgQFvDoqiEk4NQ9eRvIEu1lZlpHW5B4LKGI4TV1DQDb+be+JR9Rr86D7MzA1LtZYpr1+0uutNHUq/1EVBbbmv6rgcogUz5TdMYDW9eFbnwymVNDKVuXMfeKNfjblRVPGIcLBVq5XCGHQgphp14wI6RDF1w1OmB6CI0lxLmGyBBkD9sL5elJE7h6e/DazkYmMprwfEzK0upxww87j7qrU4NDeV087rnuY3TGRRCAd08wFY6lKAVgplofQflgQMNvNmxrQhp2fY3kcP4MpID+KwkDlXLNROjGTqZwQ7Qb1irL2QVuEaJoyt8UKMrJ36axrLnE6Oznv6VlFeNEirJyygY1U+JCRgHWMCMBYAO9QxpXBHxtKeG4hTt9vS5LNXiGCMU7gCJeLKItvay1yK8L1i5FrIuRiQK39Y3U+oqvB6J3RV9Eikvf0GAhwHMYvLld0tRe0EveNeQA6+Xxw5PcPLV0dNVJM5y3PH5MJ6/PGrk9qb/C+qJE+MlPkGnQqpjZV8hoqMKqAx35s5DDvJm936+9v/RWWyLYHkfDozmwF5GzzbsEKmR+k7mL37T/125+f/fPPT7pt/bO7PTtV/n/2W7vz6t9+Hf21sRSCNFXg51o794F76e3ZtFJ1MeJp8FO99UXGWkdqqPvgoyMeAnI/kL/66/KMg5C/uvhz/5mIsK5HhB1mZ6BN3bfbcS5/8p3hk8hdSCSDuj+KjwK7UtCztYQaJof21g5VqzsoppOBGQmiIu0UfxEP23EfULA1qt2gCdS0sVm44mw9cka3gHdDk45pf8Fo8tFTk45pb/VpyJ7we1VKRkileMMNUB/54bL+Uu+FvAN7e1jBRAx+9i8NtWhuQj2th0+BT2LQ1t1q/bREiko+i9og2XnH+GivvYNYAEYEpoPMnFlPiGj2nMaTQLgIrYbS0HG9pmbmELdSgV7hQijBJgo5aK1wbwyKY9UrC5I0Z3aHomcsXIogH9aN5B14ExEWdlRjlIEYxtfbb0/MzTaSKh/z72dsgmkOGZLLWdZQCLhtsZCLVnKqMZZefU8qg7lKHN4SR3zz6yblNSyU/dWPyRi+3klEySpoXAZwKutpCzqeHbw/JmRcWb9GQf3ZvS30vXjYQuO4X2Fz/eW1znDuxAupL7upi+7e023ya86lwAg0U4LfMvMrlHChfw18uUSOMm8upv3Pywdp9a+p2R2kiWizXev12J6MzURIYKQ4roFnmJHCGvXQt5Xt15Canwj0cO3vrswVRWYKpwtLZ318fvkUK+22Di43f8AtDMRiBa+JqNybkMLfqYZT0hfD4m207bcLRLwx/uytwgD2CqRU1YHWJWne1cGgmMhdiATwANi347/eHW8noN8JESktd5U7DthZDK66qZe7+ytj1gPzCFdMzqq6T5wHh94X82AUkbnUrOjGA827gTyMIrHO6l47piVawQo/HO2e+42JuC/G5dTkPDMRadV4mGqLjBZGQ5C8V0JizdHRdVcofuvZyfoIMgF/4hDfALml6zcwDDJ4+48YN8ijzxr3bY+DUv/SYOP7H2hZ2xk6/kbPVjGb1LHkFevX66xeeTdb2CXIe9ikB62FAcmDX/6KptdpD4FTwJnx7VnLIOQxx/h7qVaDw3J1Vv9mRhoAeEkhYp1mkvf4XzhMfQ+I14BrDOV1YyV9l5YCYtBwQXt7sbfC0KAeEmTR5/u1h3qQtxK+oLIcLHX53fkreyIzlaGDM4/IZnqxfWywmFnc7iMHII1Vqlg5IyQtA6LeHTgt0A5/fsxz9M0jQENDhRoGnnUf8XfzdXfWIo3jkdlFi8PTT3POSQehWjoXDOo7kjIGJVXcoNCw1Az8+xnZh4Ou9I2401XjnArByrmBG8VQ3e7KEUjUhaMyXIcZBIXsTCh+4pYLlGerDdJJTjCSqEssjgGg5MXa6xJfKa5dF9jc0ekDmbAxGHpjsXBhVQaGhkAW6WSpYL4zrS7p5fbj2cfzgT7BVkN2wMUjRjBDRkEsNBkBnaIvVw7M3IR/nh5rtBPqM7jAopqTecoXh5IbPB+ATQkVITwKs4zp1oAvtw6CRNnSt/N+Bb1iFGxUjoxRPE/LGRRn9VrEKByYnF6+hqjZ0z9TB3VkqmTL0pTjiCsOE+u+KodOlbgfr8aFdAu4D7l1YnPbxOBPSn+nE5dXMJNpsdQoJ3HREeRJorls0QEmbwPYt98ON/12KZn0QIwkGavLJwifweLcmIeeYDkNV0fC31fLEXXW0DbhWYoy/CsP8GGuX35If46L5DJtKxX8PvqSlu3rhApKAkuQpT+bB5lkHh3/6xJnOir/PTJrOgr5nhS1ewneut3UWZZnwqhwgjg0Dn5eTcJMUPHJ3rI4YGQ5UzIMhp6i+cKSKQbykExZ+ZNck5dRdYgzIifPs12Lo+M2vA/Lz+wF5zab2CWtHtjF6hg2mcZjl+4c+Vft/qvb/cJB6N/Sp2v9Ttf+nav/fRrX/xxZ2j1NqmsK7vlj5ggaaL4KwegvNz/T9mmhutCcbjXxOLYMOEv/0Rlp3yd+7leZX9D2baY01/GnsNL+qr2iocZHKIg6deJyhVld3oDhq00hLPLvqGGlgnIVR7zHSjt/8ujQqHxdHVcdJ1XXB+gX5arrDvDk8uh2AxvyrTEQ5qjPiu0gIm1VH7sKD4HV3IelxTH54sxGB7wt4RRF2tbib1LE74XohuPwpZrKyvC4Lhem1Uk2p4L+j4tyIZBAyTvKHKEfGMpY5LR/TbBGunE0MYUVpFj1xwZcQNHf+U2MjnvrPPPWfecBuPvWfeeo/8yfpP1MqmVXpMuVJH5si7Wa4RRK1QNRbw2EDPs0Up/lqA5e9Le4mc5Z2U1VYWZ+eWbMQaK2nzRh6syBmAdS7iZJFM2pNuRZ+Ue/zEBBdj7QomU76Sgj5kHV1VatvV15aQz2hTMP/lPA/IDnhD5nnDKoOoT/A/lWHBfTkZTas4brYZZQU9yWR+ncYeDmCO18UVJiWh6n3/H6ZLtF+UyKGWBdbqXUfeNfH57S/vydtNR7Hx2IwoXg6Q4KCIIxGz42QS5rKoqTCa0FWrQMnaIMYW4mlcR6rDtU+rWoIGb5UKSqmEFEz4blhzkUL7Q680gcFOyDoVsCDXnEMYNTreUh9uD+g10xTfSUPV/W/GZEe05BXv2oJ1yDPII7OQRzdQ6IXEP7o6cSXc+gnR9mSdMvXOP0utfknVb6jyn/Hevz3duK/sBL/HWvw37z6HqeV+DpojhufRV/dyYRrWX07DwbZrA3NsbgXxq36WT18p6YubwbnoN3RC4fyrw3CrRISWMQgNP89HhUKNIShHSA4pgshrcfCzlAqXDE/oBq2SmfcsNRUalW+OLcnjak6u/tpf+9yrxk0P654nl2ulhrXD10qYe+uQWsCC0W9TROXSOjIIvCZQBXhm6gsccinTGVRcEPOfz7EkACB8dsMkrL9ED3FEyY7kxds/2WW7Y3Gw5f7++PRFmPD4XD8cv/l3t7+3osXo2FaxyTc41hOZyy91tWqeNORG76DLL9C0BdvmAqV/Loppfvj7a2XGX25/3Kbbe8MX75MX2T7NNtNxy/TlztNGzmafEUrOm6GckDucZMLBMjflUyEmkVKThUtwHjNqZhWdu1GOpLScCW6qVjO6Thnm2wy4Smvg7VJHSrf1OsRnZc6lSvr0HEqMtgaMSUzOY8XDDX9wo66yLVKM7UB8SMDMs3lmOYdvODXfQthy9gpGTX9zVos44P82V74mpjLecqEXtmVw2sc3pUBx0TqNub8YW+2jSSU6NCa0OEUAoTciLGppWRBzs+O/5v46V5zbbDWTs2MpNZ8nLM6/VyX2SdIPXdD6s3nXT5zWNJ0xsLAW8nwMRrd54iIaIqacmRTsVqu4vnjoDCzqGqR3zfeIai4qnil1SaQ/uYRy3OqNqdyc5SMtpKX7d5NUJ4sXRUKf5aFBRl9DWEy8uH963Dt5DUYKFrBda2S8LqM6+0VGkNJGml5mSWmZeWNVWyWWPWDqjd6imm0QerKka2t7fsajn/B4nfOkdnVBeDa0IUJeX0zJjGstr8o2cD3CjAz2nykoILWFbaJy/b1OVQHRJXFgGTl9XRAxorNB0TYL6asGBBRwdf/oqp75lVZLLuNq9XE/IY2Z4n782wlL2Plv6n3n5CfobvSYzT/X9A4ImdSGUv65OQTSyv889nZyfNQ6/abUquPzj40piGGqikzwRkHxbs7avbeztJaYsMZupIwIejeiNM03NXY3MF3ryTUwFM8Z9C3oWuAQ3U7OTHkSKpSqmam5T3LXL32GJaaddXIB670jMbh0feszI69YvMpLK1lHz1wWXvJdvJybzhMRi92RrvLro8X5Sobhdfl48CIKaBKHNZ/OztxpfUPhYeCbGxASxd4jERwEfuLi8zw+b4TLqZMlYoLQ8ZcQE0qSK4kdGKYggZhFl1oi0rl2sSkMmMbcSMS4opheLNVY8V0maaVUlY7RyUU8+vTGdxEQIU5o2gwewF6rMt1bzm6+XyeTLhibIHdKce5nG5ik84NxbC9xObWcLSzORxtGkXTay6mGwXNrd6xgcjZsBNyMU1mpsi7AmmY7u0Pt9Md9nJra2T/yFK6+3Jvm9Jsey/Llu5058vMX8Ix+ALR/YHWP4dTnZ8dnr69SE7++2TZdaz2hjosqu+a+oGLWwt8+OOnwxMvVeHv9iXJ2t2rj9ae+vBpL+ijr+6+CFzKw+en6L/Gs8c2XOVBCw2oiueSn5vd+aDIrB+O8GwzIrmob1FodQA3QVd++pJnV0RODBNEG7rQvkcfTkW40SyfECrC7tpVlRzZiX0Q7Wtfqw+uGxDcOgVjOb1luqp48vXQ2d4jiaopFNrQA7toaFKPeLQLomMt88ow35mqZnkzRlhQ0CKW9Qa7RuM9K2KmVNJqRxC3zw2/aaQHdHnP+j/XwJ4bc7Gp9WxtQNY2cvsvtM4fkLXRMLH/N9rzffRjvF1C6tXDDJ2WB4GJqQkix9OGHRsukhf9HSpq4eKjEX1NE1f60a7YfhpX6TUzhAqaLzTXRAoyk/MwZGHVsLAnZG7t4HD4oUc/VfGRIW9AOoQXXNfuqH8Hd24kVAx0pUueclnpUKy5uwUPUE8zdqn5VFDwJ7NPXN9bYWosZc6o6MP9j/hT3BKHT6CrpZshLgrXoRujKrb+SMixQerKDt19/u2UKYOOWN+rtSc+NqIt37AvVYvSyKmi5Yyn2FlL16c3HvWG5jyLU9ugkV+ljZ/PKhs3jFSirpTh2oj4V+tXfNJmPX4Ydk41qQQ4t1lP/7eT9+/fvb/88Pbi/Yfzi5Pjy/fv3l08dssqSGxaVULYOQ7fkMVwewzVvdUXNX9aKwMkL+WRveMsrZ8bqZh2ZbHqje7ZPKuV8jjU+e92x6nx9+e3bTq+51mOVUug4InVeanImh3ukEs670tP6+gFlG32NVYtZ2L5Ai9J0G+GVNqVFp9z6oGyPxPN/TwLgpX4lGOT8Ih74Y2LVeSmlAttGhIVzJCFb53dNAS6Z5M29uKeg/dQPBUFFdnlko3nVhs/0NMo08GHLe2AZEAuuuZiTja2w0C8MhPmittt1soMEi/N81qqtpsgdsTtZ6g7sa5DNqDAuSJBxVl2wzBGboV13G+PN2sr76hUN1MNkXmgGHN9Xeu0IAzGg9s6rPmn4yjRWmBNyBxSQxrdDuCiABK0PSAYCAOH5MOH0+OBtXYKKbzRQn76cHqsB7EcpFGN+sIeM7vUfBHKxWOF8VCTCS6Nu6s+kkIbVWELeepsgXzhhosxB7kuloSlIKWyzC6FK8mCGz6NhenZ6TFRrNKsURa/rmPvi55NoHMSLg96gFjTcEAo1N9uhzISn2VrsSe16WGq6Va6s7ubvZy8fLn9YnfpK+36DP3hPGP5mKPDlokT03TDxLnj3LawwM0jWvp3Y2jtQKhyNG2RuoIAtjtg1rCI6oz1FhWNOpyNrfrshFQI3q4n8+caO8VgBePYB2D/A9y25/JytP1iWWKxRy4pst0VMaw3x7s4RXdSPaOjFc16/vPh6I5pt3b3Vjfx1u7eHVPvjrZWN/XuaKtn6u8s+HDdCwgMD21IfOhUTVIXQIMRJc4yUETzgud913ptzlBSZY/nk3vnYe6dZfyzNWafHEBf0wHkEP/9+oH6F/DkDvr23UG37NyfxyvUv8An59CqnEP9+H7yEd2HridX0XflKnL79uQxevIY/eEeI0+L347jaDW+oYeg4sl7tDy2vqoT6YFgfT0308MB+4qOqIcD9xVdVcsD9004s76Sv2p5rJQs+RMEWdeL+TcJt64X/OcNvK7X+GcPwa5X+hSM/RSMvQyd/GnCssOK/h0DtLt4mC5lzT8oxe+0NkLdeiF2ObpiYrphFo2ZHd8anQ9Vsco29Hc1J10iOTFEgXeLpmztbD0UuA50XyJ90g7tMbdOyn5QRw8EFcyrJWC9NZ17xrCWRbytzmnWvW3ZGo72Noa7G1vbF8P9g+HuwfZOsr+7/etD/YjAM7Pl6kg/CMsXMDA5Pf4SZOCg/AIs04HVW4sIZ9lYuoq1B46bb82DEowUgK3lW7C0Bd8P0I2GVksozkt1oD7Msz2iAguyjBnJ+ASyq81BGDJusk/JWMm5hnqKBlgqNw4I78eBvoZ0ygioBsLk0HBZRI7xZfFelRbyh9Ft0y5lqRRZk4+Gbo9V2a3Cs731UO1wLpXVPC6xSbNUy9g4X5FOLDk4EEkAsX0F30bD5kwWbJPmPGVLY+PPYbD++1iqf2oT9d/ANn0ySsmTUXo3gfxprNF/ezP0W7Q/A3Bf37oMU//RtmOoAfQNWYZBc/wD7b4WDF/TqgtTfxM22yOipL8/g87j548z1zwE354xtjwBfAFLra7GNuXauNW70hLv4+9ury3xCmtDYC0IUNZ8/Sg/gC/cLYVevmIU1JeCKmarUlffOWUHa6WRueLGMFe5Ykw129shTKQyg+K7YXNeSRUWqLoLrGvQnjPzd6sjnnyC0LT3bPq3iqmF+27QDLuE6hS6RFqWdWQV9H/FaKurvLy0310lIe5Y+lZm48p4faMec8yMV41vmKJjnnOzAFjqGJI6QtGe8PcnP13+ePr28P0/cOUs82puR+n89W8/VodHw8O//+3Hi8PDw0P4jP/567JKCmwxSo37ItTvbDN7hAGYWPfSbiNUU4ZxXbeLevvOwoKpJpbnQZZG35uAf7cXfqMT2H4NfTLDkO75QAwwJXlmkXn+6wCQevLfZ4dvjy/Pf32O+x5H8QQYuKktICmYr/uMU7LfKiZSbCDmJgRCtaO/+fD64hTmgrH9cHlOxjWUN1RBXVWSQ04FDisqaOYMa60p1455/Mu798dIuCc/Xf7NfmqAHlFZREQhwD1jKS9oThRzuQFomD1jyZRcrY3Wrnpijtb/uXZ08FEZ+lGx7NKY8uOYi4/FgpZlwj6xB+SgAGGtqEXLuaEioypr7jcKSMctfESwbq8QSWLZVcz4zSoWcDgeK3aDHTvAavGuMDtfR1z8/F+v3ywL8DVbfMYx/pnfsA0s0XPjwvzkxI7ZlWHn715d/HL4/uRjbTl5lvz24uMR6hx/Rx/Lx9PCKiKveKhjaAkRm0Dqj3MuLAItfS1tWnUKrj5omRAJbceIA50t6gd2rXDigOf2bcTHz154OLY9CPh4zMbVtK6peX8BzAjOL0mhcTd6mMPL5m73yKUgrpUc4FJNHaf+6s7yWSG5TDNjRW/BqDDgmaKpFazUMFLyG4kBxEpWIiOUlJyldikePqiZ6T5ATDo8oLG/Zp1+5Jxf2iqxkLghFqTMqX0SW+ScHJ27EFFyEYPghka3EvSIwrNdDLDFTi1t5ASC5WEKlPFO1nEVKSO1PYeL54JcOSwmV2Elh5bhpYqZEPhtMRT33fR+Ne/Vg4rQM6nNILRiGvgo8poijIuGHZA050yYAfGPQtdrbHua+K5V2SUvE3I6wT5DZclcPsDpmefDRtbQ8/JqgGXMsK6scEgDjFHX7fL0jBjFbzjN88WACEkKCipVXF2aG5iMgvdwvKhTDaOpDkYvt5JhspWMdq8eUHxshb7awzxHnk/1jGkkAyksQpQnLKcpYR6GJ39or1lzkUqj+QfZkDX+3KihXBwXRHNTOY8rVpReyGpdWVLQlWKQHFDbSQ4wQvOpVNzMCktPzzBXiSk2kfCGJSjLMkGIBQCeLx3rAPHzK8Svb0yva3+0/SpKJuhH/Em7d2r0PIoMRl797fitHpBMFpRjRyZ7xqS61qZu0qSheTh0K69rQT+4zW4vTvpb7dpVO759eta7uKb1r1fWw8/TN+Tl4CbcBs39YqNym+Flhv98h8Cwz/iqiaGnbZRzBo4Yl/2BSSliUbfoC23w6NTaNRYAl3Hn02MIzZkyEWUJifWZYWG1wePrYdspolQdNxpec3j1HS2dCHBHbAee1XqgsoJruL6yeq6SeWi2owf+UQsYEPvp8fnm6dl5/UPo/jsgczb2Q5aYkogt6sIDlcpdkpYeECYysIZJxgxLMU1XWDXcSirNyLOT4/fPXROdkCLETPqQao+VmbVbEH65Nt3QyyBuCQfHs9SsyqRYhPYgCAScXPjLMkxJUsWoifqrhL3ylBUoA5h1g75jC+vcULXxWqrsAeaUawy+qhvuw7rzOFIA6nxuKFygyzZz/QdR7HgUBJxY0VMTh89a60fFoTGsKK0NdBopXq8ZvV7ayFz5ZfgFGNKde3DYdrfdHg/9i/wxl+k1Uey3imkDCl5ZjXOekuO355hr9vPFxdk52SQXr88hBVKmMtdLS4pVJSwe4hpPj5FNce3z8ObczFwlWGj3gpwT2WSkStZuFM8eewnnQQQzGi4d/LfaviqxdZTf0mLlds4QUINZYdaSoRm7o82Fa4Lim58ssfwl724eeyLim1ZYJ3jkfM72cufi9buj/7o8fnt+aQ/B5cXr82XXtuquJevvG51KjLQ21N0VKuK9DrvbKw3CrxaNdniroKNMdX5O7MG7vq5JJtOqzgBuzgZWlj2Z6+s1PQlpaioaWJsgja6UKMm5uIb1YIiEbw0Ht0SIgrE3NWoh5xqIgLLTdRj6GAsmkjm/5iXLOIWmPvbT5qO212pabFVBA29blKuZGZBS5jxdDFAzQY0A75O91LXWE5zsB8l+TCktWN26OvafOR/m5Zlj+ZevUMtaFk9V9Y3wfnDHSBUiEQKOQCToWiagLRQJA870UuKgyTC7YmE0HOJ/l8XdakPMLqImq5tEsRuu26rDmNlVA+2As8NVP+ouLblnTSGWATAcm0jn9Td3GEmH7jm7yb5dOdXuwgX8T/Y3QWgwHlIphNueSVDU0eQhik2pAm+qZmCe6EH0PO7/mOM9KfLTSS7ncD2mstpieiUVuTg6c6MOkN4CmAhbyvhNHQXDBTec5uT8H2+hOxEzz/Rz96Mb1A5Yw4J3L0iLQelqz+QYZL7o4OOHmgt4vEBQG3WDg2PR2UGEpqb6/9h796VGcqxB/P/vKRTuiF/B/Exim3tt9E5QQE2zQ12moLq/b6YnQM6UbTVpyZVSQrk3NmJfY19vn2RD50hK5cVgAwaqio6aCWxnSuemo3Okc8FKBrblombZmLT8eC2jP2BXC4Z1UIgK4CoC+bI/Wy/RKm/munAWm4Ud0fY1pba4gqpMEeJhT0BOSxOg/wxY2BGDuirghP6RCxQKuH/Cw0L7dtNgBWmF1LUhB6CCDRsxcrDqUh/g8OsOhfIVF5560SQhio2p0DzG26CvsMdSQdhXDCtsl5Q6xx7pgzw1j11xgy7/kxUXwQZRlkHbhuIozR13Zn6OgXGc3ZgCVajbSPC80948Ks3TlDA8fcNaLNik0fjUwdkrEGzAg7aEdDLJ5CTjVLN0uohzjYfByzKcQOpx67OM8afPgINXMOM+H+YyV+kUpRne8Voerk2Vz9tOuYK+t8cf24S64zY4Ic4F/0qUNHISEfJfBWVpek2nCs/by1s2vXYwObm/iOwXF0iyso0mjBVV3BQnuavbBCfZEZ9cGFAuIgTrok0SNmFwaE+ktRmIFMFBotlOKxE4VEUiN0bCHHyZFYRjy8vgOISm0HW3aMVBcy2FHMtcub7tQPfiaw+gazWNA63sn75frRV0gcBfGo+KkyYkJUZksoYdequ7vVfFudSZ/1kUFJg/7KexGX8J5b9JOUwZOTk5KOHdEE0zT+Rl+Fq5NiDEzUAREujoEuh1y3pUxXWW7JY7G6MA3wLZTZeqVsfjOOVD5iGTUcz1dFll6A64njZz4Z0UOmOVJq8AjhSaCyaWVhrvfakknp2sBt97mekR2YcIENoAZC50Nj3nSjYUwXkY0uEU5Pj0A0Tq1yA82J8J1rK4aUFqZOgBFTSpU8o1Gb8FnCGT5+BsN817IsWQ6zzB/TelGj7UD3D/J2mlUrRek7WdjWi7u7m70WmTVkp16zXZ3Iq2Olt73V3yv17VgHyAgNpXnxXL1tw+WjmYpL7XeptQPCpA60kOyDCjIk9pFha51CM2JTHU/jLmYqkUl93vdPmwh2doCcVM4IUAhNqnEsOY+iwryik5k7TYWRC8lExGU8XNH3gg2CaxW75hkNh7qQ09zINoOYOhaTasMWxsQyZ9Z/naqURfKi3FWhLXeJCxIZdimSvqE8xw04Ja+8fBLLiWtKQsTI0r6h8567MyoarXjzUYmq8ei2gD394X94SV449Xm8ZOOv54tb1a3hvGNF4Cwu/2D5phqdbk1tE97lpfnRmfz3pBkIQRWu196KD/fv/MO8O2ABi3ZlKxECWZZPyKakYO3/1zNTBAywsAXKtU0oT0aUpFDEswuKuTGclkblZmxcI0eE7kXMkOCyUVhASAFLLnSwJ0JxcwvWqdgJm+m6FVyX6pseGemTeW7LNEHEMkWcaS8yYTb/Ed6AzCFIcjpnQwuKMFztEGgCcTlnjQ8r6zDD1r3xYJDe0gxBeGs27eQGakNZAyss9FsRy3jKfeCr+oloPGy0sb6JQwLOoHpb9YzJVxZGxrRHAtU35pU3jwYk7lgwH/6keEZ6Bp/ev1dXwEnzAOzGpEzjDUSEv0yr/ysT8F7k+J4uNJOiWaXhb8Q1c0pUoTfS1JSvssVej1CqkhhASLVRrsz04OlY8KbsUyyi9b9Q0voEaJ+57sy3L5z0LegnB7Y2SQm1X7JacpVisNAmVcWENgHBRhKxgqwr7GbIJGDAQxwGt4x1YWFSvWESHHglAyoZnmwTkVqUEASsIWHDb/s7/b0AdvMYFpk6c2bTKmojioImW5agcUsH09VR2hPkvldbOYN6+J8roJadu6vr6OGFU6Gk/tCCgYuDKo0q3Ij3hsSyvjKCNa1DNFXDGc3U1TRKC3VN7vRSrvd0uLr10S4gK8UmVM1920GKPVxjUnJNEZ5alZMhOWcdlQeNkg4IXtlpN8LSfngMb82u3Ocs4GAwbVts2sVlAs9ivs7ORwtY13bZdCXgt3yFoCi1jl0nbn2KAEjMg6WQkWSVRXkNV5/bBBbpjhEsjBt60ZQSvOUooFJ+ZTj/B9SW5yxbJouSITngAUqWE+Ii64HCRyMGtbpIKcHO5/NCprHzE+9EOFsvKqjh0bU57ec7c37iaBgZw5XQ8fjIyWvGOi+pMd4BnEXqlCwYPjekMERtpnmSZHXCjNrMiUaADn7k8mUHj1unSJQiSXdu08u0S6vVq2N89wQr3uAh4bBBLhXGIMTMgJnKwOxDKrfFhKgR6BKG0tg17gYYwKhtKjwhGECimmY/5nEMSIJPQfP2MbFT4gF4AF9ADP7AeD3YXf3GMpBsiralyMSBrsJeO+NQnVrYUIHkaULLdgyjoQc5y7PBfNdToyHp+w1YtTOeSijlyguiiorjrKmUyXltfr+26B4MFM7kQSCiZYeGdGyF7yPhX0nCZjLlpt0soYWL9ieA5tsW4Lmw2DIlw1rCAqwn11c69+93btjl2Hv2GUMJwIFKF/CdXUQnhNFYllmrIYikLYb0sd/X03/wEXCS4ev5RTOVR2DftGBW5uSFPDMJMFroDZZMTGLKPpEntdHLk5aguQKw/+Ch9Aii12x1qttXRKYJnAyQ/e1ivXjyFjUGRDYbOLCzsgqKpEMmXsxboJuEs3B1udzqBEjKXonoZWHz70RwgMjkGInW/mSMIVVKnJuAoUtBxg8pmQCbMn7iWUi8tpX1kCBAYM54TVe2V5L63WpyMExma4j+klU4RrMpFK8T6Wl/DyWbgCRk6NQI6ZzniMMgsJ1BWpLadwmQUDDnucpzQDeP2QbMy160tTDZ58L7WNmOCYayaYbQfHWPGCwnVZAgPOEmSJ7IVHG8RmYMoDmhxUkwvznt3/zHYIHw31wSCkDU5ssrHDtlh/wDqUbcebezu9pM/2Bp3uzibtbm/s9Pu7vc2dwXZJHpd0/F+yHJ2wYUhLoJ2AWpUIVdHwIvS4sCsT9Dsk6ll5oWkqr5H9CVc64/08TJmwY9jclyyHbCB/HgHZYGVbBs9LXKCR0hQS8OFcuVghwh+zBOAf47cxVYDBkfEqeWwz5EqryJk14ckFHujmSvuoDBI45W8Y1appEHRt7bYETWomvhqIf9Qw8qIwwDCrc2AWBp6NBS1+Gg5HQjzW7HIrC5FM2DKNXy9N1IsETFnRM4Ek6GuJusiLkhnBvey0ojPvzW+wTINY6rAiDqTNQ/wKpiG2AyY41L1aLK4Q+64xkR/UbiceMpdy6kabT5YqKjkAoS5RFQDMs8jzILC2LKhWBiMDgpnepW6WVrJkSrx6VdiXUE/PBh7AKSog52drV05VZeaAtIl6YeW/wo6VsKK5GOZcjTzXikUJS9rsFySflLZ6u89JZUAloVtg66VYugim3P2QVwnF8BUtVJaaQsE46Vkla6gVPI0tUmMqMBpTsQYzwc231rH/dcsaWgUp3g8a9IB1A3D8Cq5lf2VJNTXA5HXB/gvvE/BipV4kOu0N9mzJTvA7dGCYO0yCSY4cg44HOIjM/Bg0YxXoqit0huq9dpbTRUmrXtyidUvsaAwlfxiO/FquUOoY4uNRS75FnSuFDtaSpFJeGheM2hRUprGzZMW3CIqieu1ep8ZG1Is2Qz8LwlZLblbxzQ1eFj7l/CCXl1uLYSaKwb0PajEXpmxjeNfxYjdq8qyMYARBxUYwaDnOuW3vhcPMBIhftQoxvHRFqEpAhDHfRU2JEKkgcPqWkOnw3tzGTRc4zYoMDmaJpVA8wV6KIwYmEjR5DIpNYVjsf/gtFWOK4eRTlPFWsyZ0ZCgT08l6GAJ/HPj4eC/ix3aeUUzDnEobMw7wFrkLBI8PsOhB83OOCh5LzHfy5H6eAdKWvi8B0i8B0i8B0o8cII1rzxX5K9TbI0RJ49QvUdIvUdIPA9JLlHQdxpco6Zco6W8pShr3hOcRJQ2wLDlK2iJ8S3QwTa2pXyxF6QOHGyOEgyxZ44uAMyuGzz5ieiY5onvS4xlGTM9veT1i2HSDzD9a2HRoD76ETb+ETb+ETb+ETb+ETb+ETb+ETb+ETc+c7CVs+kEE6iVs+iVs+iVs+iVs+iVsuhQ2ja0x4Vl7nX9WfDP7Or9lu1CZRZVSpfhg6uIwKfQBgGrZNI4lloiDQpQ4F9H0qxRyPP3dQvi7N04Mwu+Ozz4dkf2zs//v4O/Qk3GQ0TGDzgO/i9qNv1m7Bt8SJMXAFg68wPbeBs98WW48czk+PG2T9397+1sbClivuhAtSmI5HhudakGOiqEhEgYQijSNNY+jvwBEvlFFWHp8xIcja5X6MpPSuVdmjGJchOj3Fh9PaKx/b61GpalYPIJ1G/0lJENtUrhrLQa95AKOGcDIpPEIyjz6Os9wNq0xsgTnaQPD4liOJylXGEI5lDRF6Ipxf28FVcKFUXLGUcJQEgM69s+c5zLec/kRtiMrh37KouttnmEbW1cfGy8+nFyVLHBkOvzumeJjv2EtempG5K2fyo7FS5czxLkbvocpBJZCZUwx9DXWCTO+CTbT0oSLIVMalAUe7DGdSTVBoz/w7TUdDhE9V1ivokzCFVd2HFGul2bMtIxgczz/QmqWZNIR779s15BcMUJr+uF3j+jvdpR2ydUjK+xr5EvXUq1pfBmNuc4YlK7FV9T62X6n0+mtk9VWlTz4SxNhlmg9tUry6iL15iVSSJOaPr0/keo0Kvc7qpBp2TWcQYz8JNDE4BkRKxy+Trh5RynT1W8Cj7I0vXa77+p0Ay1GTveWWj/rdrb2GqQPvp9BoYddo09m0bZKCRoLcyRkQyjdy+LIgRyPqU1wO0UsxBAjoiYZc3kWdW49kaqYm54hHevCvjx6zv/uDMKqvP9YWgPOi1B1hLPeVxOHY92PvJ1Od5YSiTrzd52YQdxnrXBm65QFWXWjWlk2qz7Ka5adjlia3pNXT6Nu5iZ1SN7m7XXppF7s/TmPHGzFbHfeYNtFLNLxmkIDnbDCe+lkYCDjXLmz0KIdhav9TrhWLB3A7sShcyzUp0+nhF5JDo241hI20SNfq79w7BCEr9FWZ8+OGrPMxrdDkD1boOd2zCejpbVkO8WuxVwk4Gzaxgs4JYpdkmf+a5uSFJC0piBPTs+PDg5/OTr/dLp//tvx2S/n+0en593e7vnBm4Pz01/2e1vb8y5IWz8voN2SqPDx6N2a662tNBXJGk2lYCWuSUg29E2vLGxwG+hXIByYYGrHOMcS/2vsa5zmil+BAr2oo3QejygXF0RxEdtLvbCFK8GrUMyJ99XjU67q533vjo+jaO6OgrMgWfZJZkjrYPJatmCJ+sURyAhSGWbz4k48KBLAHBeotle85WT6Ac+ULomFywwe+ejt8gksMqXVJu6vBTq8IZwjqkbRONlaEmMOSppJDI3xzYUO2rC8O9wiCYdzJDkgh0efPP/KqW5QmWCOJfMW00sVV5qJ2N6U21acVI1s59swPsJfuBfcwFuSomV8PpmwDNJxgV5VTnTe7mwf7LztHWxtvXl7uHO4e7T7Zvft5pu3b952DvaODu7CEzWi3Sdjyukv+91vnit7Rxt7G4d7G92N3d3d3cPe7m5ve/ugd7jX3ep1Nw+7h92Dg6M3vf07cqfYap6EP72t7WYOeRoGyZX351AxKnLqYdbN9u7O2+3t7f3O1ubR2+7Ofmf3qPe2193uHe2/2Tx4c9A57G1vHXUPd3Z3tt4c7Wy+ebtxsNPtHezv9Q73387dns7iyJXK57J1boq1OCyS0l03fTSo/2Cxjw/CmdwnMNUaNxzbRqbGjdphxvufbUYy+SSlJgf7bfLh88/HYpBRpbM8hhuXM0bHbXJ48LOPIjg8+NnFGs5Ppj/oxrK2aXsNDpVUitQ1nNeW2TA28whD8KZkwjIjUkaUTk9P1gs7mpARFYka0ct6FEiyybb63d1ku7+1Fe90ezu93b2NXq8b7233aW9zUakRUp/TgZ5LcJKCuWWhoZqtn3EIqfS28PWICZddWtr0FRESwotZFqTZhiuQJ3VroNfpddc65t9Zp/Ma/kWdTuefi1oEBt8+VLp4RISt6TM3st29nc5DIIsZvfOGRd2tLbWSJKaQ+WzE+P2x1Z2apWmpMRYmp7oW4sbHrPcAtNTjilDsZmtvtq3TRLSMyG+YuezVs3m41KUR9bUfd8gM5Sfc5tCGUfI2i7ZGf4hsxRoFUSwXpTnqysfQwzXNW2hcj/6tmnc8xd9A5R6WmmQ+kMZV+QRva8/RN156wIedptkWKDnl+M2IpalsckBmeOS9re3zvx28Mx75xu6m8U+KB48ODm961POldSd/5utWZy+iKSSwaH7FYGkvi54nHK0vJ3XBvDacfOV0//1qhFf/Zh6zJrOpoXeTOYDdv7me4p1/ILZw/9rPtY0GweQjiO8q8ruMVXb4/pSEGBOyYoZyC0mttmHoUqwoq9/Hv/pLsLzvxAK0gCIEd5n61fHAhsmAIlg5eA/dGA0QRpJDSnoa15B2FpYxrskvfDgi+0rlGTU+u+02dbCos1CmBaTQLp0OmKi7crAKqY6qiubnuVvjNuCQhFr3jtHEt6nxlcO7cO/g58+nbfLB28nHIgaFDVtVEXPfDm3pBk77dfMQHIfU2iK5d1ksd9M4nXOyWju8M0JhtMWvnF3fA6GwRMSSkQqnUmTlwz0W9LGIHwhnmp7ngi+rWXQT6jQlZkZDgc93IEFF+u9BBqgUdi6zcwgQW96Fld9TsTJZRtx8fkc9a5NTCDf7WJPzA5rygcwEp3fB9CE8PfB5qA6q887h2s3wcnqdXmets7PW3Sadjdfdrdcbe/8/uDp3Re7ebt2t2FX9uJmYdffWOruAWff1Zud1b+vumGFO0/klm57TdGjWwWi8NGfOjt/Uh90nYF2y+kL8dHqnjSTALc6zq2UtujO8f7sKL4MZYWlqHojtTwV2xNO5fkXlf/JV3mq0EFzpyVZv7jCHGQRhXydSFPnpd6nSdGSH8OxMWMavasz0dz9zILe9tbWx44gvEva1Gv5wN2QV/3Me5s9CFBKA+Z8+njPgpZrQGG6a+rwhMrfX2dy9C+iKZZym53PX0bpH+ghO5SpkwXZVeLSNu2T1sLtwOl2hlOLkJJ2MqMihFlC7XHusOOy+5nokwTlLjbFiPCx/8u2Hjkc0ozEUPqgSeWvr7Zs3ewc7h0dv3nb2djt7h93ewcH+nTSG4kNBdW6ot2RleFzOAAtJ7YEINcVv0EdfjsfM0EeF+aS4tQ9kDuEQ5G+SnFAxJAfZdKIlSXk/o9k0IqeM+XCQIdejvG+MmvWhTKkYrg/lej+V/fWh7EbdzXWVxesxDLBuCAP/Fw3lTycbGztrJxtbGzU24K3K2h1VtT0EeBqXV3mf14FRRU6NaMaSaJjKPk29TVj0RLwjrk/h0j6MR+tweEyXtqqS3MERFl2a4dOenv1c2LVtcvLzKRXkrfFWuYpl4PO2jacTgYe7FG4/G3e2RID7YPTU/uysxVpi6EMh+Ayc1wq+d0LpB3BE7c39cq2noNyzmdSaMzVR3JgbgSX6JzMCCQuPxaegQ0V9vN5o44UjnUCJ2KZ6AYrFk97Wdja3J8KUpv0UFPscmPalTBkVTQi9wZ/IIKUltGzBm7OTUyLYUGqO90zXFMpnxEypQZ4aA9ObTlAEmZunbFyqIEyA3WM+50KwdO7lJthXfe5CVB+VlT4uts/gK4CbJRH5aCsJYdgJCYqpQIHb/ff7tlCPsQ+cbXh9fR1xKiiECVNlrNExE1qt61StASZG8g0OazjuzB+iryM9Tn+i6USsORjXeKJWK6FKWPkrcA5SeQ1ZnKoudQbK9W40t9BlTOXjpQocV5VgZhA4Oy+kLntsjXh9RQOnKqVzi5ntG/0sI28tbItG3tZReqrI21mQLInEy4y8DXlxJx48z8hbC+d3E3nr2PQtR96GPPk+Im+fkisPHXlb4c53Enk7J4eKUb/ByFuL44NE3p4uFGNbi60t9gKEqeayPUqMrZ38D7qxtCCv5iBbnPjBgmw39jY3N7u0v721s7XJer3OTr/Luv3NrZ3+xvZmN1mQHg919ao0HU9qMac2wPI5BNkG+D7IbewiCD96kK1F9mECPk/nDu2sKNiGhV4LClraQn+JR3y6eMSQBT96PGIjLb6xeMQGHF7iEReh1rO5wLlTPGIDQk99f7P0eMRbcH4GVzqPEo/YQIbv9BooxPS7i0esIvf9xCOGmH1v8YgzcPtx4xFnEOT7jEecgey3EI8Ygv4Sj/iI8Yglwr/EIz5ePGKJ8N95PGIzrt9WPGITDi/xiPNS6tm4s3eKR2zC6Kn92QeNR7wNwWfgvC4aj9iE0g/giH6T8Yjl6/IHL+aPJlipK5i79p3QTNm4KfheZnzIjfBhlFjDRUvUm/tQ2/FiyWF67w31U/4nSzCUDa6SfZQebCIhmreh6ApuzkTQi92EClcduAmnOkYz8GlsxVPvNGPmc7024HMssdK9URM6ozHzbXf28eGM2YsmuGeXE+NuQ8ica9gBEZkU4uiKPn2UZOxLDt0SJKECrvftuLZZBaxcCq2c+4bYX3KWTW0rnkL6B4M9uru32+3vxHGyRf9jDpIiFo9I0yrZ4DPWIQ3aGtpeLdi9riCZDRjrM+M6Ei2HzJCq3GXPjmw7JjnCjqhIUnS1/CTQr3bNBjayxNFaVem62R/s9QYbWzs7/Y3NhG7TjZjt9faSDuuwzZ2N7TI5HayPTFQ37dzyGr5jWxm6nrC+gSa0BBkzqvLMeo4gxF4orQB7kodi7DaJCjE7nUFne4fSTp/udXr9nYB4eYYKyxbe/fzpBD7OLrz7+dOJK6lrO5MQWy0HnTxpprT7IfYUNa8ovFa0TzrgDf79jEErQ5LIa2HEQxIVj9iYtX3f0QnVI/u+JC6sdZ5aug/TufcQu7u5ZlFZGjT7LNddCvtGHguiJHRAVcxoG0O3MZ1i6WcbF3780WC1bkhl6IfN6dJp258X0GrDSgENLo9tOSkzNna4DJqKX8Pxw1C6JssXtmYUUqje5LGhdJSPrncB2Eva06A9KHZA9Q1gMfrTqCM3ecO+bWXekwWYAsGniYsLSxlNUKx0qZtnbXSuCNyXK6YJN8vWxgC3DYOF1EYvZlMo1D2CfaP8fmVwNy02aSXjXGkYpO+b9yYNDUrxNAke7jPSmohhUHfJvN6KzHflxv82fPYaq4tZvMAQKHWr9ZAqsuL8PE2zaPjnahsw92P6JqJShJFstn9UQlZawz9bbYQHR2it1uVpYo9tgi5Ow/F8p7B3kqGPRYNfuz4J3NGg8P90EaxWLSetCr8ufrrAS5dyP1kHdKXz3iBP79Aa9Mk6hBwPsPOCUcDQE4yPjaKxfcGmMoei34UamQZcV1qGEUlckIs8S6Fp6QUk8kA8JKghXMFcwemdwIgdlqBHBIaZC94GC8MPGbZdbygvX9ZLrzc3N9YVo1k8+uuXn+33+PknLSclLjk18Q1x6tVnMZYJttv2Wg5EWRHFmChR0FOuQRtwQQTTaENIwbU01j8qGdkH6yDxW1Gf2Xbj5hvgacaoCllOIcOJpHKocAzzKpS+10yQP3LoZV+EeYF6NLt1tX+ylxDflM6/5oelyujea6o8oO2SNSGkriubOwmLGW3GzyU5mlClAn304MkwdviikQBsalEFBj1PO9SbDJiPVI8qcwQ60RKiVZlWZgtem+HpwGvrbzbCIQv9WoNjc7N+3L65uVECChywZZoiMIEVVvy1z5Rtdm9+sUllTTh4eTc0rQhVbc/5K+w5aK+E5xLhLJHR3mg2ettISPMurMSs0DEYMxDAHtnm9RleUMF8/Vz7p9rBZIgsWjx+RGxmLggbT3QBD4COT17Yt22LQn85yiGgXmhONSN9pq8ZK+cH6muJFnFlYy16+8/fkP5Go/0scK2KwUGl+o79OuOTCfPrzzfln9UCGpnux3Id/GVGWgMpS+3+oZN8+MW321HesAy6yqtZbeXnayZfjTLyB0zg7S1rWZ/C6LPVH2wgVcMar8MvXjeahgjvjK2ojJkTqGXqLD8J6GxrCOO2PiVfcpqiURG0KHeOSLHeiza49uiafY3ZBLfmkVS2bXIuEmtV11ZrBP4udR5/4FNUIYBDNe56rjL3O7Y+LQ5BtGsaBjPXu2IXK6YdUMAryhpCfZZidkV9ATev9rJGCGmLPj9VOhpP7Qgo8rjmqdKtqDgFsG3ncZSSXwa4Knvp4XWSk0uV93uRyvvdklppl5ZnAR5qcWu8u8DvYowWniiYDUBnlKeFg9qwTKma+w5Qy8k5oDG/0r6znLPBANvYmlmtoFjsV9jZyeFqGxN4L4W8Fq5xdOX0BJVi2x29gXoLl3bYx7+u+qvz+mHDFluxHIMcfNs6H/T9LHVfcGI+xQ/fl+QmVyxbYvjDZzt8g2EdQlA693SfZx98ghTC+bY9/nQWYrmJf1/mqDjhUfTJoE8Zu6Le+bVHc7aRu/3StjQz8jGiVwxOYRjENcgsOM4ROuNMWfMQJgG1IqGtOBXwGk+cpnBntFQQCpnh1kvEHSBQlGPLuLl6lI2oGDIVLXfVh+2O8WhUZtOCtGDajhnEdMnBLJuNCnJyuP/RkHAfhfbQDxUu9/lralvcIUPmnlaqkbpyqs38hXgsGGaTvGNMyjL7SBrMXqliC2+bPd83Pah5evtpn2WaHHGhNONiUSKAtD6ZNMLsTy2OSIKldXGt34L5Aj+Ave2riF3p1ycp1UYlLizNiMUSt4aQizjZoiAGGeAPLmOfff9PWwsAGolk2GGytM0M4Goa9aAgVEgxHfM/g/NaJL//+FmxQZ6aRXhhXop4cmFkED8YBC+82RhLMUA+07S8tYmkwRI3vv/i4loV1LhIO3hIIXV3AqrIOp0bxLoU1oB8RIV6OpKZ9cNkRlI5DG4kVUNOLQWNuijOmUyXlgvrC9NgjICZiVA0lTQvVqW1iSrovPpX65L3qaDnNBlz0WqTVsbAKRPDczPgAuVevjurxV/XOsP8hzTMCuyf2DQrAPmhjbOCDD+weVYlwrdqoFXxeJYmWgHki5F2HyOtoOMzNtMKIH8kQ63A+ocw1Za5k4cxPc9jk54/XOQBdnAH5/e6OZfxe5b7bhnEx99S3fwvu+XM3dKR6Kk2Ql8I+qn3uPl10z02QB8N8iPsbZpmQ6Z/SFfdov7EfrqF4vns/0/gpFsafK9GwKIUeJZmwqJIPEvf3EL4YmrcxzG3RHzGXrmF8MnNlUd0yS3K37HNEgbNnNOhy+EIQmdI8e0cATQ4hgujEZDYDAVLxwxjoSnpZ/I6SCX1a/FsxKY2+0CN5DUx+4Yg16zv8iMhV8EMxcWwCKy2mdG5B9UFNc8f85IwM/xjKVc7W5WX/ONICnaLZ7AUgArS1avi0AHNeAmoZ5OBU1FxgRycl+SgitM7+SdPU7q+FXXIClL9v5GDj58tB8iHU9LtnXcxSO8djc0X/7lK9ieTlP3G+n/nen27sxV1o+6WB2/l77+cvTtp4zt/Y/GlXHU1Fta7vahD3sk+T9l6d+uou7lrybq+3dm0nWk8cVU0oGOeLisV4sMpwfHJiovty1gyorpNEtbnVLTJIGOsr5I2ueYikddqtUZAfLIG97eVP/cBawmIoTXMnCEuwkRT31sgg9pDaH7W5AlF5J38g16xKlUuWSbYsupG1HDA2TzYWAqBXs9aCZvRZtRZ63Z7a1DJkMdV6J+F63RvnrqM64Cjs5j4n1UKOHP9sTjo5rPrM2ZCS9UmeT8XOr9pTdLsmtfW5HLLstSAn1fuup2oW9V8ywU1qHdzy45ntHVgF12lVtNZi+jXk/3389hC5jlnBdGsSKOyNviU7HZ6UfcL0XS4olYxr2NC40umfcENhWHZVBEuhpD+D9Xb8U8YnyolY26rSpkhhMuvBOcHvCODtXZ1sqgvXWknQ83mOpF5U+w9pplGBvsmLDIWyywxw3ExTC22mg4hAwTyUXPI0ob2Z455I8xGNoB+WeNi7QthIqYTlSOUqm19tybISCl1VE8nPA5SUWwgNFQjoT5HVjGhZEZWWDSMyD8Zu2yT33jG1Ihml6uQAMqvWDol3mIGTz2jA6jsWaEEF4JlM7mKQxB8yCJXMFiRFRfibUe1v5XxX52B5M3oIX523EWxvAE9TDix40IRBZcMaNzqJOFWshw8JVkxgo5dMpgjh6bDIegCO+SHvmtXEwi3k94olHLbjbBB/tzjdkgv26FvDiUU/KqwdXCcR59wFWcMThCqK8yOCRAE483iy4Bn7JqmqWqTDIRftdEhpQnp05SKmGVqAZdkaadVgNDxIVp+RiSKeqme+nV9Pa/DvESP5cPEVpUDDMDjXwQHmWvFk1sq8Xqtn6eCZbTPfcVDp/5rP8zeB8w2UBpojuQS2jA1qWWauNajxZnAXJkdVLOhXG6WNbQqkQNnEBh9nsUjrhn2eQFEdI0uFCIfVJFadzZiirk6Bs4kWvPre2UQHhsfgjti5jr9fHq0av7AwtwpPOgHLV5wVb9kRt7adbtaSgorupt+yWk6VcOcZkmEf0PV2S/XrD9i6WR9IM+h7Ea6finkdcqSITNDr5cQPLek50xFIz3+1z9gIA9YmRjFs/9ebSy14ErJuLSfelbWq3+1HF4LXFrFqdksXL7mkqQEio2XJvIF/UpUULHMCsuyxJzC6Q4rREDxeehBG18ptV4vyvjr6dyVYgOIH44Myzz9OINjJf9FM0lh8dk9S/ktnKawG4azNb09Y3nEVywac50x7P5rdNj6gH4BMU9/iq/YOWS5nQfAqfM4Y1Sz5F8HUMLYTxvqVs5wLz76OpHKaI6DX49CDP9d4++xIGMafzgl2OeA9KJuL9puh7USyuSwVVc+fTxYoOErg2rgy14gTosGB/ZB422ubmBNfXE0sahhdRzNS4KlWSYGc4exVQ0rx4erLqPXlngvZcI3bZYEEysjchzmQpK8fAdiJ7CDugu7Ol2ru8e8on89ovqcq3OzBHiyamW9KuN+9JqsHx/+u4FHa9g7o9PpLNDQGsp2LK1S7j7JGNYsmq1gSvaz1TZY9G/MNR+i++Np4ZjhpT+p8KVKmGaOxEO+1ufCfAvHdvGQ/9X88bOn43a3uwAZjeCdL1X4rRcpM6JiKppFtbGbSrfT3Y0WEQozvmBZdMVEIpdVY/jMVmiYtcEDCARBqKF1xgTtp/M3zohlxqJ+0XLhJmQGqaS60YQ9NcNgmnZGxdBeWXWijrG4u52oY4stmD9d9/wRI2OpNFHsimVh4a43xsRUdkRpvE9jsSnFlBrDHRlo7UkquXZEGTOd8ViRFao1jS/JFUQ/FJUhsGbWV66nbTLJ+BVP2ZDZ+pv2yluzDIuQrrYJH09orItRwwtsM4Yf17w2zGBYM5QNRQGYbNNAKH06wwhoML+cqQ6iu5bIODcor9Ys1a1oazEWM3HFMynMaHNdQT0Sr49CsG5jOhVT4ivCgZRYDrXJXTgEF6k8Y2Z89QxYpNl4IrPnxJ0zC9FtjIE7njHVORLakDThQfWadmm/dryKH25dzEnh5Z6VgyP/3tXwL514FK7zyvtfD1eLzR5K/WhofuppBGwA+aTikoshHFG3TuQ1NPJnCc/HLZTm1i98OGoBC4ybRq56hqleffoRQRJU9QASgquKuTRMVYy1EXVsyZgpnCEmbMBFufqlGaF4uMSjQIrgCa6IvBYsQeuFCjrEs6e3x59Oz6IP2RDbNpAV+MIoT/L5dA37QAsp1iaZHPDA1QoaJrTJ9UgaZcCVK16rJRmxdAJ6H07UFYtBOI1lC3rCWF8TKYLLMs3oWBEaZ1Kh4XwtszSZIaLiKokEVzoayis4s1izqgjEta4M8HJkPlG1LFmideG53mhhQLEVQz1QFG4TpNBlCFr1pp5mk4zLjGvLCJKxIc3gEjhQAXejYM2IN9PEfupbziG/bnX2wuNH6NVwUGkefONNFFfGCkhxc8A7GPREzMJyB5JmsXytdHhWpe5u4Uklxzry6ZSkcji0dczJ2ckpMcoUb3ISPuSwE7peUEWDJ08RFufa2HikzwXNuLFjTtffHb87Ks8mbAhwXybwDGygNJ0qqFUKlZEdlBJO9C/9mv3NlU8O2+5gzKDCmurm7TYUytUjTw6qyYX5AfpxXEQwjB1xRNWIKSdvh0ef1pgwu0a5YbNRMz6cFwtMXZg3L6DhAFSjLl2v9BmZyElu4Er8vR/eWyEg5uVIjWhva/ti1aN3dGWZSnURoxi2ZKwdL7u7o+JiTbXLoDhSYFcQpEdYHM4eQBtu26MscqFTFQUdTC5s4XM7Ivwcp5wJbQk6/y3ISy/vJ+nl/aP37/5We3a/9Omek0LPppnZnXpz/yj9uH/gHtzfd9/t767X9vfVX/t766n90ke7TITvs3f2t9cv+6VH9qP1yH7pi/2IfbG/917Y32r/65ee13NQ59m4hnfrc/1d9rb+TvpZf989rL+ZvtVrZubXpM/g6pmKeCQz/LgWu4hEe9/yBp8pgfDfYewD147E7j3mdX9/4I7+4aYyTW1nNzg2NqA2nnRDMtJIKh0oZKQTTbnv3DaheuQeDh5sAND8d8gmGYvhVmENTvaLF+EaBT7xcl4SFS4xqgSfwS/SfMz+dEnKs8HDuPTKw2M+xLjJ10RnOSuPjhQpDSthsdiv8MN5k9zMQN3zB8Ji4Kp+mGfAFJysCb85SG84FD53I1ow6F15euPIhrjGrGcq4kLp4FD0VhrBMQO+S9y7hCduWcSpzJNiBRyYj+6ePyNjpmlCNW1eFO/srxisEZdehYDAwu+gSXIOD5y7Ic2TMVMKg8HCNVLCHF6K+JgOgxKWRXmHMV+j/Tjp9jYa9UchIMdmBHJ86MMNEVxHESseP5F9wyl4SKZJKKgOIAN/hFA5XG9hdePDN7I7mMMBWIQi3jyNR8g/v/BMc0hvZa55xTiYbUzjERcM1vhck9kXouCFeecKo6fO51BoN78176yTTIIWm5Nx9vHF+ZaxYWH13TxH6dHG8Z1aSGR8CbJq9cKh+9ywvPA3sDvM/pim2A4elAL+Zla4GslMn6NmLuwJtx3jfGteJ8zYNj1YpOFGufxKSYng7gDlfvyPTcQKCNb8SiPRZkxlNM7is4GmCxbUgrNW3pxv0rtPZ7sJkp/I2YfDD6/JL/LamBdjOjFKVrG/1mApbfTk5s2ezNbnxOt0BCFykmv230Juf8FPDYMci4EMpdVuC9Ajz+maQEDN943iafeNo4PTMFPYNXJTEYtVNB2nkX0OU91ohmenQoq14s1KKU/pu7fNlvTZrCkVwXJD9KVMGRVzkndQUAQSagq21+eVKurnPK1PWeeo371b3d3DbmevNR84H04JzBDGuTQDEsuENa6Dm2BROmM6Hs0PjJsFq/mJqZfAy7zPMsE0XO1bOfx7+F3DuMXv3uYqG1DFoCSUwpu1avHSrZq1BPTNMlel+EQmzWpnocUcUGAi8VipzlwzVd6gw+8600eZkM/Hh/WJwGWe0PjhkCpGrE8mk5rKv+dkrnrNjMkqTsr9J3QDNuVomxn/7//+P8qWq6mDZDX4X+69VwQ/n4/pZMLF0D7b+sucCzvAye5tYzqpgwzV//AM7NnBHcDWDHzGJimPqSpX97u/EBTjzpC9hE1SOR1XPN/7T1yMO2NiOBMb5OmDoxwMPGPqW0yzu07sh7112mY79P7z4rh2z7PbS7HhffRfNIxrfyy2Ou9nN21NxdhkoX2JfZ3XErYzREWQ8g3WsMX4D5nKS07XaK5lwhXkmBTo/w/8lRzaX6YkfI4EhwG3nqs0DBUaBhYOP+SsE0f7XIQHT+WUkgUO2tyJrL1dlgMPQFBfqXlOftN58Izpjmg8smUeR7SU12vjZmwLXsb1qKBrQpIcywlomul84q6mcCBs9D7GlGJ/VAhh0xOa0THTBrHMphkB35gGLwE7tcIX5mPb5q0CaJCcQFNoQqwwqOD4Iz5hxYvwpA0R5ZB3VAIJshS0Aso0k9AGXE8ymeSxXpyQEK3i164dxliuHrebpr2zuJSmfaV8ybCVYObVW6YOclYXnBnf9ReTHv1AFhTJciEMo7lohiPP0rvN/vnTCRlBq3LjPcF0VloBkpuIHudZ5fak7LnNmPW3EYNlUOB3TZUXcevl0lyPmNC+HEdGhNTeeZnkqaKZvSyxyqz0XTj1LIUNz0daTnh8A+lsHmNi9erN9DRjkSHTrn03j1E7uug5bnYTpeiQuYql7gwqaoINFwC7Sa4WAe+jHQ7yBTKm80wo37LexxXZhzCDw+gMiDSCW3RIZkT4G+G9ZNO5QJ1ZMqSA9e9s6gl5yXwylZ298GabOMrHTGk6npRgCQIcF6IZIg/3Ew4eS5CgFHLB1wgWVekJWyrCg4WUpQTTT4pnZxHXflnCJiiNsgg276zwTegUioDJAfkIRPNiybVi6SD6j/8XAAD//2ZX0gk="
+	return "eNrsvflX41a2MPp7/go97lofRbctbDMU8F7W+lxApVhdRZGCSrrTdReWJdkoyJIjyVDOXfd/f3s4oySDGURRaXJvJ9iWzrDPPnse/sv5tf/p+Oj4p//HOUidJC2cMIgKp7iIcmcUxaETRFnoF/G85cDX117ujMMkzLwiDJzhHJ4LncP9U2eapb/DY60f/ssZejn8lib0/VWY5RH83XVfu52/h1+nLjxxEofwjHMV5TDkRVFM87319XFUXMyGrp9O1sPYy4vIXw/93ClSJ5+Nx2FeOP6Fl8Af+BUOPYrCOMjdH35oO5fhfM+Bp39wnCIq4nAPH4APQZj7WTQtYAX0lfNWvOOIt/fgr7aTeBN4ZfX/FtEE5vEm01X42nHi8CqM9xw/zUL6nIV/zAAYwZ5TZDP+qphP4c0AoEEfrflWD+DrdRzTub4IEwIVjJgUTppF4yhBEMLqHfrnDOEN/48PBeq98GuReT6CepSlEz1CCyeOfC+O57CqaRbm8GWUjGkiMaKervbQ8nSW+aGa/2hkvMC/ORfwXpLK1caOAk+L0ePKi2chLVotZppOZzFOI4YVk42iDM6PtmQvC1ArjK70qqbRNIyjRK/rk4A5n5czSjMHJuIRcpfPKfwKa8JDX+11utvtzla7t3HW2dnrbO1tbLo7Wxu/rRrHHHvDMM5rD5hPMx0iJtMX/Oc5fw9Idp1mQc1B78/yAo4HHlhnmEw92LDaw76XOMPQmeG1ANz1gsCZhIXnRAlsZ+LhIPi92JNzepHOYKt4Ff00KbwocRKAO94pWg6hL/7TB0DQfLnjZXCiRYqAAqiKlaoFHEoADYLUvwyzgeMlgTO43MkHAhwVSP7PijedxnCquLqVPWdllKbtoZettJyVMLnCb+DKBzOffv9fE8CAJLk3Dm+AcAF4XQPGt3C4cToWgCB8EGOJ0xfg4J/wSfFzy0lhjEn0p8I7xJOrKLzGOwHw8+hp/CLMFFRwuhxusl/MEG7wRO5cAxFKZwXAR6O9tQaYCibPBPlwfD5aWBhAKkwMzIcDxdOFqS9mEy9pZ6EXeEOgp/lsMvGyuZMaN868hpNZXERwCHLeHE4lyvHKX4RzPeFkCNckgM3BRGmini4f5LswjlPn1zSLA+OICm980w0wMT0aJ/DjuTdMr+CXbqe3WT2597A+3I94L1eoDvM4oedfyF3aOPZvE4UYr3or/22iEmwoYUwRZL2vvhhn6Wy65/Rq8OgMwEpvqlMS10gQV8+B3cwKQQZHxTXeHiSgBTK5kTgKL5kjzD28hXGM964F8xT8B6BOOszD7AqPh9E1RTS7SPGk4NfCu4SfJsDnALkm+IAYVj1Wvp1A/hM/ngWh8yb0kA7QXmEMbw4kL0+dbJbg22JeoC/E0Wij7t/EVsWQ+QUSScATRY8Js3H9XhTnEvcYSDBugvckZQDh2oz9ZWJI4CyZSb0vgD6EiIG4WbqpaqtE2REAicBGoB0FkDM8c7nZPeeIp/NREoD10Kbp3uJFbOn1uYgKjpBGhvCUa9zf/skHkksE57Q3JE4cFrqOW4mA3TkaN0zqG6ShBB2RXRI0ABUYW2Bw5K8wGODc+ML5YxbOcPx8DlR5kjtxdBk6//BGl14L+FUQMX4AbvtwJ+FBeSji8XwGFwIg9B72WXj5hcP7cE4J3AJkfBEJyRmESlzRt2M4i+LAlXRKzFK+0XKoa3jW9zJJnuwbc/gViFeAbBiHtEAzEufLZyFxVggsTJZRcknEAHDp5W2DC1QzHt0ojwHLcoYaEjEdoHYVBUDRQfDIp6EfjSLf4bdJwIlyJYYJSBkUBbhqFvmII0rufO1uux3nlTcJtjfXWnBUQ/qZv/73ttfbCHdGO6ONzmir0+kOvY3NzXAz3NoMdoJdf7jT84fdzmtfLRH3Uzi9Tq/T7vRA0nB6G3vdDvy/8/cO/ON8Ptv/bwXhkQek/JxgtOeM4A6H1vGF0wu4LpkXn0eBfXihOA7rAE2ivJAsVw5WzuEAUIHCwWIyvv0ASL4HrwDNkYEQl8nXykccoSQC4EfpTgrgnp+lOR4E4G+G5HAI1HTAGBIFA7pOeJGqJ7TjbSKgRxYgytuv4O69tv45if5A8fTu+1biElIYpkv03jXJZUBViQpFwcLtBdb28N9NbFBInUQeTYJeOUHYMT/F3IwliDGI3SR2wkd+jZ8WP1+E8XQ0i5EGIgUQO1QDF9cp6FJMj+F6Ax4kvhBDS+wkx4mJpyCSCGnI0dJQOPUyogxqbFhEEoYB65DXFxFQy8pUijCDIISToXpk7BvEKKAfknHQVpmjyK+A68Pu43AE6u5kWsyrRwk8yzpFPKgmTvEMXl18fJJZ4QQgBVx7c+AfBf5bwRZF+fxCoiYfq9Cm+F0UxlwNmkSxXAVV/SyjuJgIhlOPkAQCyGAevD6xMgJYhz8BARBVuiqIzXEknAXhbgDUvwiWYAO7tCZgC26nnfk9UwrNLRF0VqRJOklnuXNKHP0WcbQP90u/wkKA86p/usYXUwiXYmHAO5OQFP4j4KhZEhbOSZYWKTwlVvrq6GTNgcmIG4LmP4q+AtxnwC6YTyP3zdIYB0PqBnd3ArCBGwUnl12CooRmgDRDeVXq6CFIiyN8ATgyjAq30gvgVgFZxJt5JWVjHCtIJyxIA0oIswNvYjJJ4Yr5cehl8VxzQNJR1GpTUCjnpBfAQiOxQXdpeSeZTYZKHr2JVcapErqsoxAsgcdBO0Lqk2wsVlQ5JiEuqq8VwotTFAPBYR6vwRHg4MAlFcfJWfdRoOc7cWTt20C97lZ3e9facJqNvST6k8ijW2UjtXsvyXmkTZ6b0DRImlTDazRzFoWzSW5KLjeKNSVYfzTWTvNV9vtTmiKuvX+/b9w1P45KKt++/uYGna8v3sRLJfHOywWiRUWEOM8oLo9DXDUh48rFsS6XhWMAIcn4KMKnCcg4+nmW74cRW0bhCxCvRnF6jdYsVH8tC8PZ/okYlTmQXmZlbfgFPm6sjC4a3DKl2eEzp/86dqaefxkWr0BuoVnYKDEVpKIyFVv/UISzJpUqaUYydYgGJKk0SSgBCUhyjxbjOqcpkHOpxgBboScBmyfOijRpptmKNoAAdZJUSSwlKW0w5ysmfhbqOp8scB+prpK6bgBAXD9cFhyROGY9hbl+NjwIJJITIJea5TMEiBhV68nwPizv91nCB0BqMyvC0uBcM5iGL0i9lSFRgOLzatPNlZY+ZR/k8dblPMqiS5eHRTI0GuYhiE4F6ENI4+GiCukt/MpyeYuFpR+UFCVlOHjsKsLtRn+G2gaCGw0z0tTyqJh54jhAdJqDWqbmgAseS+STlB+p5jjN5i18VAofeRGhYTZBK4DAWzYjo4ACR1ogeiBIEWDA+mNFuEBBz9JpBigJ5PMO+i/ABOCUN6U7EbazsUPglphQyDmKzEyG0XgG7AEWT9hM7yiCeY1gyWEsMp+DBpqTefHopIVqMPNTtGojA/kKDyKeuI7zLw1ZJfdpKYjvQeZdyzVJvB+44osBg8yWJhO0lWhhMZixiZdZ4MCNpgNcysDlZQ3Q4DUFaApxnmVxkCq04IfURZyYlpbc/zhGDXv+D+LVhgVqXoT5LaK6ccZsx7FfsxbyBn9gY5tyeIm7J46eSWT1SHY2rYUxAj/AJCZoMo/jWmOPw9T1QR4+b0ix30dZu/YUPqBsHwpTn7WcFN1/sOCm1nRsGBnUZJX1HacZcMv+JMyAqtQscgbLn59HeXrup0EjoOMpnKPTjw5OUVnhfn/hspo6TbGk2gPd9xIvqEKKyN3tSjA8ej5NI8VrbJ8MXDtg6wHzXxBE6ENlBav/46zE5OFrv95wt7ubOxudFnzlFfDV5pa71dna7e44/7taWeT9aFzJRgfXuS35qPETS+oSDMAo2UbB0hP8NgapFISrDG6KyRDRPQaMmcRFg/HtS36nLECMyVHGkpAfIqUXQjMI8sACmWG0yOJxEWmRVHMWXl7sTC/mOTq/lQPJl9c3N5ZwnBaGl5zcYxHbBSbE2ACgcrdVO8kwBdaftAO/cgagp8AbTd6oTzTDTReq/fP+onU1dKXEmmpv1M+zcBjagIqmt6xBPWDNcnSiZCtJ+JgnvDo6udpEOQn+u71m84aJ5zew4Q/9/fq1uCVDc+GWN1t7J+s3vHqGuh6rLLB5mEgI8BzQc9w/U9qw8yp0x64w4QDuG1q7UP2kdcfyJ6gLYCiAqGGSzQ9kzDj14J56MdoS8T6OQMG+Rv2DFG40I6Hbc7Wy6SnwnbtJm1ISyYssqhdBTWjg+N8LPFjRvINQZu36hN++lwjWs9dROZNlJMPF53EizmAR8iPJAVk/C4PzOuHv7rwJNYqLaHyBkWd6cAkLnqNFC55O0VfBS8tnQykzqnN+qx0ozGOM4YQCiCYCDLFxxXMYBreCOvyK+UXZs8PBRsJjg7EJ2YQ46TQL/ShHFYfMFx4rneSmpiCr2RDUPljqaBR9VSPSM68wGm9vfZ0f4SdQtVkDDSubI06izQH19a8Rci7mjsO5k0ewyjl6/fX5sZKKsXzkNuBII9aH0ctOutZ1CJ9w92fvD7RrfMVP3dnlSpUVGtCwTl+BvSljwJl5toTcSkwZzfAK/4H2FVC01JGyq4lDOgyxIY4lqpCMgNabcFroyAt6TZvzK2jtkgvHg3sOSzAsWE5lBUQkIp4L/yd+ZylDy1Ik9MzwTHBmQCZtwnJsvGoZEFChWJUNwSGn1/VoXn8n7Htjwnbl+vraDQF/3MlcjMCIwTcDflhxTZ8f2bZ4FIxdVJFUtFdinWqalsY1+K7nwr+61uVrWUisl8chD8KGIkMd9BgrLb5zSYqEPIrJ7wH6UFrjLcYNLBssUKTTc9rGefN4Ho5GyIyuQpxVIIrY/asQ7ulai92Bl0l6nUjzq7UsRxCXlrRzExFAlJW4YlwSt0ogy/OqYQ1fNJ4S4cH3TRmJKi4iivokliOP9L2FN6BqZG6zKGPaBtglkmbsaMDJ2cs5CckAl44WsUU4qfcH/ROKheIdH6ihTFxZre4uhF/jB3J7VEQdGkgK2m51IqSS59+ZaQ83tpprAk8qrXcFG8Xwi4oQ14/hmArnED36oUAZCwZkkf9mCEWzN49RvMnGorKqkUkyyI73J4MnyHa9PgUdBMVjd9E6l8TIh5r9eLLqIoDTXjSFCQJSREdwHg4vzLIQ9bJKmKInCA6GHqbJ3IwHZw3DQBW4FyK8aUC7wLA1dH3QB9zdQDF3+O+IzwrD6Iw50bBWlZco3KYGqRqJclsQ5MYgqzmsJSwyz4VynV6gxsfmOgoKjpLq5gzS5RHpqm45S+OwKR9hP8s8iuQnxKOZpK2Skl3sGP+y6fXfK5fR0Eu8cwq3wQj4LCTpNxmf44AcC38DzHTQRDoL7JgJ+cXikAlOhXL4LinXGg1FikMyyjyVHqG3wb5PDruTyjsF3y0O9B45H3RgLgbw6ghBD7PEeqz54HUahYUPGjfZZY3RnQjm5dh6vUi8inZKiBXbH+Uq8sxeghgXViGC9rNwAmuWTzvweg4XypipvDJek+eIqHK5IdNLLl4VNmU7e4UH1QNR+LyYXBpYcNgo10sVALuLd9wnz0ZzbGz1TAOI56K0AdOXGAUqFUSQKOC9EQi1mWkeI8t5RAkQyNiR4LQxmQYGDJOrKEuTiR0XqXGr/+upmjwCaAs/JeG/8/HTT85RwMkaFB8zK1PLqgS9vb39+vXrnZ2d3d3dWnA2yG1rACrJH2juXn4DLBUMjZjMh8CSRcUKNIMoB3FkbgpOpj7LaZvtILxaVq0VkijoycX8/E8dWvDohNqYx8F5ED4cz0CUggiQJk0VWj3L26itt7sl14IIfG3ukh3JgOejA8lNaK2StJUXGrW7vY3Nre3XO7sdb+jDAXTqV9wgHqs1m6Hp1VUbPhP6shph/Wgr+iCpqxFsfSMYi547CYNoZlsZRZLzk5BUMZdJrOourXVFT9Q7Laf/J7Jt/U1Ntsy8LSZZ9rbK/T8NDZQQYD/fsntnymXvvp5cTebO3fePyUnZE2hbCgQ0oSt3beb7etc5aLm40ZYz9qfaYIlx2tE4Krw49UMvqUrK13nFdZI2pc0LZ+09ya0p5KZBeJ7DpB4KpJa0C784p9Yvi8VewK88LCeGWlodyY/DKMEkXZzUUZPmy8eqc1bRLarWMAWNwUtqI6H4J1J4vSmJ4BEH6Iu1IPhEmGhVs8D6BKvLYjUQ62LWWBRlPwgiESNdhTJhOmht7JYIxVJqEphmrK6L9NAxCsN+Np8W6TjzpoBXTphlmNRAVt3yqHBnosAMFUFrSzYDFBTzOe9D7wq9LkYYMF9D+ap+Rd5PPb6O9EQRLQEtwb+sy048/PTp46fzz8dnnz6fnh0enH/6+PFs6TOacSWChiIqTnl4i2Er1Ff0TsehRZgAmI4KuHoZaKxmsNLtngwEY7gMB73heqyeYjAsa33mUdYcD2YtWq6nX/BMPQod168veo+SUjnhXsbKtkgfRDqmjUumUyhN4rmde43pWLCXXCS/kjWS0ikBU1jjYzxcfdhFJmR9IFzr6Q5bYoml2BToKszY5eiNUbUtLP+GoqGYHGzqHLXXzbOAf8tdWgYwmnEQkRdorHiG+eUNiSXqQTt5QIT1V+p4GJUFRPayWKRaBSOB8IuJiBLAPmMQoyiMwaswmt8wfpL5gCNR1NC5MEwkc+SsaIG6g72gSfuk3nwU2MJ/NMGiFU9kwqbJVAwrLwgRjbO606RuaYU3bmhlGrPEurxxyetklKq5eXqjZM0NRWvKahrNKuq/3JKx3MCmdfiekkMZZ5sSRHl0IOiJN2biH+UaESpCFJfKMeiIkcNiUpKD0tc30BLjUV1AhomsleokoieoNJKdtaYWySk/6xzt5dqUAimUkX3EtkorIaolwsTQNiaxhixkIt2FgYJAMiqGeLVJYZJW1ezNSLO6LcGKyeBIVeeS2c8LUoiMCWAstNZikAnxHKCIWEDKTIvm/BmV7SqSj4jnJPaO80UTSjDYwJQ4aebIWYW0KExCjS1VeRGBY0eV0nrNgivkTSD7cVL/nISC2iXMZYodzzKTykoEWyqdSpuJuWrYI6VT6UgVlCRf0qle0qn+M9KpzAsoA3pFKcCmc6pMFvGSWPWSWPWSWPWSWPWSWPWSWGXxpGeRXWUsqLEUq2iKs5lbvyWvKLQSiqZZdIV2oIMPv63VpRTRVSDl6lllVVEaj2H5Ejsle5iGDexvOCdIHIRUt/Hxd9hEntQdZKunS5ZaiMtPlTEVVMS+l7Spl7Spl7Spl7Spl7Spl7Spl7Spl7Spl7Spl7Spl7Spl7Spl7Spl7SpJ0ybCuLYcuS/f79EZ5FlgkcpdC6OhpmXoTcsmMN8bLSQAE29QDZvEX0iyKopfv6AETxcqdnsMyHKqaYgN194VIvEmmdF9PhQOSGkWEiBfDhTTb1IEg8LHo/aaRnayCiNQRGBaffkav7mHPAG2iCWX4r55s6rgQsAHKyJ4s/SIANA+DVKgvQ61++f8nI/cpAhvJinde/BrfzaJqGysvfKWqxlzOFD3YATz/94urwX3I4wdr+jEN7Syl8iep9/RG/5yP46Ab6lnb3E+zYV71sC9Ev47wI4oQjsToKtppLLDrZ4ijutB1h4t6EFnb7rd++3ot7WdnNrgsHvt6ot4S1pZFUw+N1W1VQ7H1NpFMJNmW3qMvMTb5pLF5NJ06ljK/pZovyyem0u0YsYb/RcKfkuk/PmFU3pqW/RukIrxkkqey87xPe+CMHyC/dd2eh9udeGQtfL/IsIO/nNssbinE8+O+Y0TuFlY5C5pckCt13Z4tftzTvsAlkUKAqNJfHKWpY8TQXNWjI7MXCoR+JkCl+2KengUcUJ2KmxsKZ3W4qVucdmTzwzcOj2zeHw57Vdjx5/d1d2C6Q77mzb3XB3tzsdt/t6s7t1hy1Gk2mT5q4+G7lUkgcaA0QxiZNDvmnYhUmswmm3ycNHjznGuhz8xbd79Y5AagyzaQZHyvJMJBp+Ot6ooFYdDDER0S0LTaBkxr0+tESE0Q1KW8phYpCBUt+fZRmKmBwMzO23RPtJ6tsErylti1bPCcC2NJUl/LCnu2mjCxDDIMI5EYr1IShZ68VFFnpFG1VOpE3rvU53c73TXccm0+hmaU8wNjoL2wycNk6I6bkXxSSucpOOv73T2fA3w91er4t/BL63tbu94XnBxnYQjO6AILKH5TldhkcIgFMY/xCqdXrSPzo+cw//eXiHrYiWtk3fZDHNQ/a3osjyl6/9Q2m1ob8/KvsLs9qVJQ1qidUp7eD49DaD2lur4w9OCC9hg1W6aKh3wa25Do1m2vi7KEQk9K8wojunuuboNmZyLEz0j1IynQEP5qZBPKxsqjaApVMBij16frAm2trO5STm6OQVkik4bM7WDYZ/kFEFOK3K6snZOelZjn+xBtYSr7G9rjo7FhNA5KNxqqvkVwdrd8nxsHa8dDZZidRi+x403hopQtgrm60L5HvF0H+ey8lFF6ssBBEoMbwKsge9qCRtNTwkFxPcDQEXnV4hD4DhjC0MuR26lT0CIx/un+rwhk/ckovHIppLlNI0XE30dvhHOTmmaMBb2GWZhy8HnuJZIo4ZzWy52yT9Yqd04XMSl51+4WCDvcls0hJfakuW2NQErRRm4+oBzjLAxVGSUWUb6N6TDsoWKgg6eAZH84lBRlTFm/pG5840zfNoyE6/gDpMoZznafOeMHTLNJH6hcJAPnciFelbq3Vo5/qx11iCEdcM8Th+Ux2ITO0LGGOoc7CIOuRGbRWKeHRcu3SjGNmDYuNoVQap42AfGSFoX4LQ44pfMkyaX8XsoVw6OrknKFIfuXVzQLnHCtvugvwm/r92t00XdzHjvACzjKogpaVjABAFS9mNXT1hqIP97h/3Pxwi4g9DBBa+H1+hNGUQodXV3BmwZ1KTksJIK0sT2RgXPaT5NEUQK6uzMQjdP7h7iiZh4IkIUymPKeQZZ0Bt9WQO0wDZSGjk5hnHQjFbC8L05NEURfyALLAz6V6jcOIrMt8jiaYNEwRqT0EaRAGkJgUPR0SArPy3KEf8h905v4VZKmvVTMigeCEcfEwrNQCHGmo8RU0eUj2iNlgv6uxC14q6Jy0h3LTNWaEXhNn5KPbGzflmpGe1B2MXqKEgOeSZHZrZqqQ05V6zuqjQntPvt5yz/Zbz6QD+B3/34b/78L+DjzVm2H+vfDpAB+ynvnS6Lqom8KhHg3vi+G3ThQ9EEJ0ZgZQuQJgaZ96EUY9NZ4WBwRzbCdIVZzsaA1Fa+DTSCZJMFvIajbjX7Xbtlq3TmoSRR9+88I+mCTtxWFDiehPCSQLqHAVXsxxqiaYO3Mg8x9oGZtBflJNPWMBOt/PkYF0ehkVdggy5rs0xF8Lo58+Hn/5lwUjRxCeTCURjVsEnWL24lf1bpPsx+D+xvNISynG49EypXmiSJm0yQVDHdOB+oK5jRoPzioOGN3qU8YzwcLq97TUzBjfNrTc0sVYKDTfKhcV6GI+POSgAU+IRY5rjy8HBwZoWqN/AvXNyAOyFUND+mKWUZapGFkMBdnlD7JsLCkOElSZYC8hZ2sQcfe1OC8PAHAF2DnqBSAT5UrScLxm/9SUhPAuFR+1uXFSd530SH+4t7tQlRLwkQTynJAiFF0+cDRFZxgCxw5tSGH74joP24WLWA/0lQv8lQv8+EfoagZ5GDRDa0M0SRB/+KeXYsUp6/pCk0X7F4gZQPDpBgS2kypoD01IxKJkS5I8DabkTuBPBQftANfB4Zzng5zD0PWzqnspAKdhiMZcqkBUR7KF/paChxLKwQim6EQq9PqO+i1woGmpJ6ydLpgGcgZZNvcuQBpfWKe6eF4Rf8e0JYok5NMsF/BL9Hnp5RFGSakTd+5zFFZRkYRO16oxhHEG9Rn/slhUcKe8+hbgv56rPFD/+SHFodt5Tc5di1bwVyhovw4WCloAwSqSEeDbbot7yuuqdYc2nMCo0nubUVd7wA1ht5ukxH/1YRmx0kqtRRry2skF/2VXoBUjrvLDdW4sozY8mJIYCsD+x/1fplK2osFwP60qliqMInYyvxRr6JgNqJoCWmFK1o9KlX+xVkHZ51NYEMajgtzLgqiK1vuWvOdy/zV/zAWZum0ZnWeRQWJWXr49b6/A2Ammy8I9ZBOhE9UIfIboGTd7S+00MTMEXN4M2btcZADxc8dCA02XkMkqRf0Rz0EBPMcLkakaXEKGQgWm/YokoOjM6QPS2GZIaELUIHQbttjCCCkcELgjhmcegOhRxXccEYzf0vhHYHYfk+wbtLROeZi/4HZcqE9J9oJJeCf6OFXJfgzpdt+N2TMzB4FwLd9QXS4fPe4nhVRPRvoS+c7JeKDh+zkMm7SQ48HPCnQPaE9XRAmhSfw4EsyQElNHie8h+rpntKGsFn31UgLw0MlJyEx7dvVvEeAOxYARMNu2U3AW8wCer21mTa1WzAmFOumUZRopLzWalSapcmdq/PEex4nvKHTzjAF+f6iT6ofLhEOQQKacx+fRgQvc+TPsmO5PJm9VptUztQtQSRMOYGTvA+fSqRoRx9X/3rjw39pKxezyL45OUvAeH8nGTJlxJEiVpgvriZpog7mNdfV0KHv5aLEg0iFOpd3Ap3gzTBoy7rUhIHx91qNyQKDKYV2o/lqpdUonBC753itZokf99qigNMQJRsUDnMKFLX7i2SGWCgdQYqocBTqQ3IcaTQ3kyNQixidKbuF0R90HS1TiFJZw1E1WQ5AdZTUmIOajEmaU4WlwlUQ6iO1DDQMU1yuueWXLSs4tT8mQAtCKiAG48qjjFFD6AtTiJ28GNcpMqQEoO7WTGNc5i9BrlIE9OuOVUEiyArPEYBY2DNhoqHDbBbKKHhvEknKQUHgJgxihNMVygIS1KgWIVGnkLwwmZ3zHm3jkN+cwHnKyGjGvA244K0feALr8MhaCES+VhV1fYjBQQK6VCnwUq3BZTXq710xJFzu4t+/PoSuCXLgORrWebK1R5Lw7NM8MVEuMtdKpivy5EAS1SYgSGgCsmBYxTkuPLfUCIYAwIIG2A7KDlDMS9adO9CekrjI1qs9geDNjjI/0eFtUned0IJhERhTFhWF3DKMw4bE+BnCIw2xwTZMsIYunNHAcnU9FFGgFygVqDsuE+zylrUHKUFavKJHV6BZ+ItmqR8iFMU+JocCC5eOcCZACMGTRj0stno8U5Pu6VYTR2hjOqlLSC6zNGBLXONo8ZEnYMgBbUrjTFnjjZgTMXzEKJ3dzITpirxGM6AgZtTVExFx4vlpSjnGkWHLjRBE/MiIcykGGZkWi95ZlV6vLZUC6rjPVqfKmWiXnJGIblV69xhagr+vZBCb4jtmTY1IDmjXCvGKIk9QcDklVVb4Z1g4vIKHe4WGZ9PNPAkV3hmCRYFcomKk9HI9aXKbvSgJxRJFiGUqE1SjKNIMyt1mdCYESLuVExuIVmc5CNYvP0ifrT0w7KMTP8AxaF2yO9jPQjZjQp1lWmaEmMGJYik5Tgotwyc4qISZZznKOD6jFsbm/u2MBnCnQLLQi0ccGGr7gNPEil92G4TvzxWlaMFgWTPUTIzEjOgteJtiF2julMABrwmawi02hKBbQX4nQQoQzhi7Jn/5fKMBewXyYbgKjGV7oyo1irBT9abchmQ1mo3HA+xxWWcoQBepj7FxUzVm5bIhQQfURqWnHRhmGNCs2kX370zeATK/AbVu1TcpqooRZTFAwLRqb1SMQViDhIRnFNJEyxhY6FXiWg85mo7G8AbSGoRGklkxREiVTH3ekhMCop1SeGH2WLO3jvMgynzmzK/gB6ybxcNlRRTeaV2nBE1so3DuDRMk9WO2uNwg2mLbXX6W63O1vt3sZZZ2evs7W3senubL3+zbaiomU5D4um86nENKVAssSCCPtIyK3NkfcofqRG0zhUIdJMshuuzOj5Fp+BR1pCz4M/11rm5IqLoGmHZJy5LgFu3Fcf6KCRaUq9VfWy6dAp62BCNJvy+tG/Ii1VNDzKPdbcpOqpoLZJGsxijfpc4IYT/1nqwUrqhVF73hymhtlMMXDLNWChjneWLVNvt6a2YenNKJnOQPMWPyZekorANan/zQrzAS//ABQgqn2GPWWEI91axDkQU1s2MYd8empaG5OYTjHU8c7z5xDVJgwnJm9iob13VhxiHS2ShIZmTwKpCuCZRpXSO2GyTKjVIpail1rhJmVGwviGjFN+L8UqK1+enH/pkNTFUrOXBpOA3mFuzCsQqS4wMxIuH8ALvjHyd9bIi+ddC05WULMEjx1Mho0HyG1eYKQYmwzIkIqSY7WCp2yzWfdX/83+wZNZ6Y4OcDdS1bqhdMqOtzna6nSCUkdQkKIeIJOcKZ5AeKGoKob9XMmAyZBqQWeYYEfxn5jNX1MUQtcuIWFgoBmOKYuX8FKKC8DmZT6VKyilUT8yTyujW9KUOQFGrBZmDjxn1yC/NhrUOEqAcnLvulYHBtbKSiXeLlb6UQ3L8xm2z6bACQ+NCfChpSQFwXulq+kiS5MU6/j4VtnKOE0vpX8/yvcsWDn/X3lz+ht53IOlePaW2+10f1s60x7NH89az5XRWPdSdNm4wx5CHKgtRynbJiltRIoN5s9FpZ2JpLocSkOdJ9mOZzjWZG8A5eDUdpNaDVqE80qthekdi+3jGUg4cBuwWp0QZOguWNaxUgABMy17tJKMynt0LriCac7hp7QCK1XLrPcF0hVoaQHJ43NyfV2jqowtYtQ1zULcMxkr9ZcsZhBAsjRumc1TcBS66dQrjUKpsBNxgBSDcsRUADr3ryfHXUFuvjFWvVeR8WZdGRCuakSeuFw0y5KpGhNkeRYjJ4RilWkvZUlReLmF+kAKCtOq2ZQTZAVaUR1dVJFpaNYo4tmYJIGqJUW72T26CYmUnlke7pMoSPwXpF9xb3jkQSmQzFIFtSuCzID4/CI50w63FrS/Cbh/QqKOzgdpPEB0BnTM1O37LND/BqlhgRKNEjsFtYQs3QWpf2609oXLipJJQIZRrpVH6iyl7YaBRnqU/kUgDsX0wi0Or6QuPTjns6kh9aegG3Z3HaDyve29boct3fuHb/c6/+e/ur3N//c0BEYKG+BPDicOU+e0MOPvuq54tNsRf2gpEGlBPqN7ylWegd9joKt8gf+bZ/6P3Q46lt2uE+TFjz236/bcXj4tfgRJqqdEfwBTHbMBYom60rPmN6hR3ZfdiP0NZKAd6OEUaW0SM2Yiht3Vk4An945WI70oRkFG2VhA6JBx1IqlUJ8NtuFwerHoqVaWao7TQuQcsMQn022NPmiOYfsPLKslExBO0SrxQiTfsiaQQfA1OysBpoW8QBjxmE1G2kxibNBYeh+5Q6LWL2VTj8PeiC9M05lU4ZxXam/8WeSJMcvWIWsq9palNLFHUv91wT2dx6rKHimFnFksjm6wyFwFVTGtRtsk2oCNA17qWK/MyBFxsGZU1ttZRvikwZKI3FZB7MmaRrmzKPDmeeoLpx+fwwIppLAIni5dg4Mb3QlLnlvEDDkrAMewxu+hkDGwupxjqIiUYkgvjSjtTy4MqGrIpBbjA9XpwEXJa7iLAOtTNnxfPVUhaHX3jO3KdKuYY8sw2dN5LoxRVTM0Oqa12XXC8pMd360mlbqaZDM1rXwcrA+ANSFuSqMSl4UkAFjbBAU2jCoO1si0TDcrnw1F9zgxcLnGoxrxFVf1aemyMW2xxbZkS+3+DLWpZLy2qMhRKVbTyxsrrrL6iUYH7JubkWXS2V8lUk5Nxm/FVYqjEdyoYYFP0qkgrQhRgeDKB2t5U834s0y5pvntgU1TxJCKfggfjXiF4TaoadLEmxXF7XDzyvVeqibtXIdDvEZfZWB6UlqPMSTeXmBykWA7aNFEIqikesUbSstTZNQ6Z14kI+VgGGMjyAA9B+GgBmnOKI6eKBKscJaEMjXSln9vVYCppKphy2sA2cQEzudP7zGL6lJG6N9clVPiZRnr5ChcBpYCDoAUGwEMqeyEoUYAJqrVx5YSfKzqDobGvEe6EjJrrFAjirSSa4/YrnIPcn/NysnIgjaiM6WRI7FOc6z/V6dDhreljyjKL89zQ05cJDmO4tSrjVj7BCM4NAIpS1haJOJA+TIxzAW9gisfz8j6Y2TSYVwju5Joa+TMEY4vlgfw9roL1n6OdqUlkGzhJlaPyTCFxb5p2Fs21OKImBzYCrIGtYkO4g2oADXGPMxv4WK8omQ4BlDjudvuFcEVmJpQCm9uLCi3vWk4xLUwzuUh4lOit8FQE2G2JClx8eCSwTxHurLcFb1bb6tTMbDsj7qArVN8YOlRCjbm9Us3FDli8opTvEU+Qe/SLjgA18JHS2ggIieU4cXwjpu+cXWtlfFWuUUq0MIin8X8MdtTcTYsh3qpCez7YzHNm7yXv6rCAkphUCOaBQiMtBd+SjpZZLCBZwTfS+qUu8LjNptK5m0EA6mToNAxMWsUGn2p88KUvQVmmnY3EgdQ2qqtAiLkPLUfTPdNxlTabAA/uDn97srfXYySGLiS+MqvNYs1Tds69Jor8YgpKsKK5SJlqibbq+mreXRwulZqfS3eUCK4QGuMzHTQHyZn5LwK5PE6YUI75dMph2At3q4Rs6M2XOUir8txxUu1trvZacYeuVvdZiIIzXScVeKSdJDGAs8Z3tM/davoBnJ6blZUrS3hhdCEA0/YCKEzQmHFmm15JMboEymXCWYtEV17Pww2yRdQIgcX2LuOckur99GIyb48OalMU6OiFh5e/zQh9e/oQEy+cjjDIKz1/gTTbANvsmJkznvDYRZesZ4rHz89W1ljtdN5925vMtHEBFtciKfana29TmdlrURGq7Hdz8xSBTJYds8AQIqVs41Qpbg2zBlucyTgCnH6FqMUR9UZvMPRwnwlupDRk2l6ywkTPO/cCBcUdDUgb3tqGL94U5TCCrINXlEUOoVhR2YHigaJ3yKQT9iWAIplUWWWxY31iCmpDwmNTbUFpUSWio7UGCWbXGHo+FjuzrbyLKFZJFwYUwzNCTlR0g7g3l5URmeWJDxg2uDDzt3ETKkQqYcJKZ+YWOGHC/WTBXqJvvIP0k8m8xoNhaZY3+q97sKZDdujrWGnvdnr7rR3Xo/gL8/f3Hnd8TZ2RuFyBRYxitnMsHgrP9+QYNHnEsulaHwq9lLxTlKiA5ZJgbtphyqKhAH8lSI3ZYg8ji02Ls//LdWgFtXhhNhlWA3pgpO/QZ6QzEGQn4FIraNxM4pDOzOCSGxLVDVRJmo4VprySHpdsJ279Hn9++3Rh/+W1TJznW2ATBaz70BsoZdF8okw+JUi8slSQhnraLHEx0v7+aFsC1NWzTtF7XMk4AMEk9X3nohR0NXAUbSQQ9ca8aW1Vx9lzsGDVBaWrFBscK4JPvIK2ORwVoTNV7RiuKv5TPavvhS9NIk8X2HzAcAN1QjMeQdEh4IkqaJO+PXCm+VkKac6CDAF8xabWiNVUNYgmc0hricV+L4KW+Q2oHz0oKVbryGPopYmpsMu/Br6sNIW8NMgCJMWBePyvzGzuSUoJPBHwOSwNkddPosJ6vz0rR2PXnrcvPS4eelx89Lj5qXHzV+1x01tYsndZAeSg2gcEgapZPiS4gLFczKyWe/bwoJvBE8+lnSjBQIhc3kc30V5ePXyDv+myhrTMOIAWXKYTcmOM5jgVAOh8qHdD217A9qF4bYSqSacRcRl15VVDx9toabpq+GkNinXbRZvL8HLyjp9bBZ3QIOLMIi85Bhe2KEkDzx7iVLYeWDeuu4xq2o1KLUlTolEmZV3zWzrAMOMDV0fq54K84Kh8ld2sn4B+jkoaBLCakc43DkP81DQ16cXZCRycnXWG3ZrGyCIAGchLMUzLMq62WJtzKaRpDOdhhnqs0zoLTMdsclYGf7NGrbLUh8CTYMNTJg2qVlaWK4AFi/ZHZBz+jtIbwhTUkAm+cCqmacGRhVTqO+Fl7njPzGAITEM0qq6GXmudMFK6Yd/tTL+E+RyhO8Kj7BS422eCnuXBN+4sWq5J1k0QQbFHZLR9PnT0cHajVd8tdvpdG1CpPXWpldYbmdR0662fGGftLvbN2rh9g37tH3DZmw6Q6W5lOUjHFvbriVFYWqsiYY0cVUyJra2N3Y27NsyATZ/3mABtg9HHw45u0ByQ5kTzf17UVm1+8Fl6IsNPQq9Gs4Lw8Qxy6kwidE0KPISD8s/rrMvntKi1ydhEHltslCbf7tfsTPQv4/6x30j2RrEW/SH0BP/3RIsTlbzc7koVk2GJcpFU9JHhqJapg6wpKRflRFhbF3mny7LqCbNYdIHRCQT7NijwEd1QmGXV1tgZ7WzvdkpodADJeUaQVlJuB4F2JNK497S2PDRYHNcblHIwoeqaqUZu8yCYXVMCIXuolK+ZUaaXieNBWuyWRsnWCXLTkbJsLfzpyUbKD6X6lXUlJH6MRr6Uat0YEqOqhHKLVkpMITduwnl64vO+KV/40v/xpf+jS/9G1/6N770b3zp3/go/RuNyLjoz/ABLabYToOD4PUlVcPA9I+mDYuFAarLJgKIsOQ9fqwp/94FLWvTjqcidnz+nQlXZyxEkHhFMUjzCYXIuE9VvJvOhxSoV4xtIGNTYIdYyVoFy1QUhopJarS1EwrWZKf6THaqTIfQG7VoX52WjFgsly9jyvq61dl1vZhuIbqsiFg15UZ+L+IKhOPTMeYVkQqvTvvHay7rR6Qwq7CFOlctVnXj0HzqxGT4kuhIMRmYw5d0wa1SsXysdG7u2HFeUX68uB+Ys0gFbCZeFOv3qoD9mxuiGTTygTkt7aMi2Ed5PoOj4nWeN6hzSuCLgC4itK/2jwlvcBHkjTdAqIBb2a2oNEm2MeddNL5w+lgt1EOvzilVRXX2+/cDwiwpsnnjAKBZYPNrXEevvL/Pp/dZvFFQIgweg+cfmAOK8zq4z3nt//j5tOV8/FGe21Hiw8fPP5aaQ7Wc/eMfbzhbXZnwIWeM/py4kj/x6Icsp5F05f1aRRxCNECK8EsUXt9nJ2k29hIR4NrwbsypYDMfH3Bp4fAfulmQa2dJVDzhnkFAxBlx65/vsfe6Lmh33D9VHj5Ps3MSR5tLaFQskiodU/YZz6cY5FnLOSUR5aSC0vuA8iBGJZF3py0maXFO6t8DLKxnlUrU5tFQhQ+SkkmZTHJMIucMtajaNajX6XXandft7rbT2djrbu1t7P6909nrdO68K+7K2uS2OElliS11d9udHdpSd2+zs9fbuseWuCXVOeDVuRdjdH1xMWkID/tyfGU6kKnuZv8sbL5d3uqn0/59NwWS7VWTvYNofN6QLNIdx/iAL37S23IUgDnywMwZUz8pH0wFCAlc4+lWr3tfSIRfp2mic+Xuo3seiiHUAWIu5FXl+FRw5hK72t7a2ni9qAzNPXb5QO2aEkdRtxaaj3F6+RQ7TaDOHRV5zW0UZYiXXTMoYRFwPU5ObQhBRfFCnkrnwQL3Udhaz+2owoBKr/TnRgmwkVlmk854euGJRNOW3ayaTXkygD8l1Smmdjmg/6hwGZ2JqHqoVqC7tfX2zZvd/dcHh2/ednZ3OrsH3d7+fv9uVEGFHDZO6Y7sni5WILOKezSowa+hrjfL/mKrcCyWXplRVpnzU+q890BI2acYZyeOhpmH7ZWxR4K0a45h0NmQTJrjFKtxw3/QuDmE/3bd7uZ6nvnrHCS9joChf7nj9L/eb2y8br/f2NqowJ9DFtp3pcNCKf82mmiuVFG5jEqkHSBcGLhjAI0XK2kuCYt7bvJbaJoPVDTl4p9S06zE9gvTDRfHWqBqnp79qEXRlvP+x1Mvcd6iEhnlfmqooi1UR1xSPB/3fJ+Nlmnt/F5b+dZq5qILaR3hg3f2DHTK0kbvtpe/sn4ovKzNij+/aFcuTirkkQrWbSyXGDoOUzMv9Cfx8Ya0UHjE7MSHXdrnXFKUk5w8HXBFIcm4VqNNisqtsHN/SbiGxahXzOwp1bWYC0NzAYzQvyBBUFc3w5UdnbR0Zy/252ZtrFwaRypXYqkGfUCsmso32peEsOp5xNq0oReXMlkwvBCA1dR6jq28JzFZtVtsmhUXTp/bbJUWSNz7PMrT84YaG+4LAeHo9GN9L9v9fu2SmjpBsZzaQ9z3Eq+U7SCx+palAP6fT1MzisQkaKDcRQX1d8OGSzAefqh6WP7HWQF1ZGXPab/ecLe7mzsbnRZ85RXw1eaWu9XZ2u3uOP+7ep8Qvptko9XPeNVkqngpnMZTIGjJPBcurgC/jYHrY5k6M2WxwNrOPiXWI1ExfMH7ZgsEwwkeZaJAM1XY4f4uWMQKCzETOW4pLa9alY6XFzvTi3nOhThJamsRGWCGYecHGJUSyWqAMSezIp0QlTPIWNUjPUzzIk3agV8q/zZGxtHgDfpEM9x0gdo/79etqaErJNZTe4N+noXD0P+hLo9A8in1xWJOhVYPTg4wGkTWlDGiZ3KdtF1KljHLGS3fpjhIJ7pU9aMHPlktalQGVKE2TFXBJqEoJmaWbLVqLCbO+4P+CXLKPld+1dlUvH6zb8uihhSPbdepaR3Lm+Iy+CLPfV1l53+LUGRakPtDTYMSgZ/v5OdbGphecK8RQk+NkbrWGP2ubCqqnyUQu1I4GNXpUXaVTJoM8P1Q9hz6cLDVogSRNcJzmE1Qa9fpB4FcxkiVuuCQNjHEcE41qdG4JYN57cUxMfakrUdU0afagHk49TIP8EpSXC+3quq8yhOswsJpZlz/8MLbON/q9tbuILI9dSrP02fxfJsEnqfM3VH3Kc2tjsDv5Ocb69VQcZhyvRpRQJpC4mYFN2/A5g5G0TysV4Xvun+Tl2Bh0e1qfRealMr4Cs+X1StcV/PFwjSouNzWgpb2KmJ67Mj4C5C8MEqz5VxFWTHDXrceti6n+BwsU5vJ4B0SgPAq/mM2xKrGVOEEIzjuUlVmYaz8o/D/j6UqztZ81QD5ne3z7c1vxWGZF8InfXYS1SSbXcRjdaIty56+Kb7iIFhXZgH3NQt4HIfFm6OPpxZfppneR8nsa83YetHGTDp2GPm+LFJek7/78fjs4+nHZY0fIPC6z0hhpuU8d6WZF/nsFGdzWc9EecYlPXsFGhf5okR/WyUaz+A5KtLGur6lMm1LV0us5EZEfSfGMDmM1W9Ud0VXlW6vZcnlgVzBgBQVvI9ZCIw/yaWWR/xViDe3KKCPUw9VaJ/M1836L/1cwUs2YMJkmTnW4MFXWlTyUVSMVkYEtDOAcEMFzEX33jABsS2lxGizV4fqJMBxNxlHooi2UYNh6BVEWAZlKExvgYJ6wG53SfublkO0lQ7l+Q0A9504zEWzNnQrLCGuip/kvRDB7oSZBlYa2AiI8VUWhBcEkVpT/YHVXzEZWidzaNmMxH2PSatwh+joFPRJuKKaOyrJsGo/CqhBE4qXhEqaiKf4fOnw09wdeZMobsptC4Imjw+quXCuZGFA5XeDcBh5wIBGWRgOc0xgY/G2mgDCT1bWDcD7jhJvKmoKn66d1ayyKzkBcIGoCvgPcP2Q/u5dhWWoGL1nGjjN8h54NrVsUpOxwS0X3q+sfNPddDvtbrfXJl068surv5/g89zO1KwgIECz6BD/WYaAtEY+1QnK+cT9RHktBeo0G4JoPbvpTnrZdVS5k80mIVcWvyzedTtud9PtPk05dNF0tcQmUMPej9NZoJRlqcfrpldCSuFUKmqsOyh6LlY7mU0G1NzgalLqbmZp6spm0yLLrTYdyKRi0xWu5QodvFgjX5SqgU+XLAuyKID0lJuXa8lMFXtmM7h9bBu9LXt65HffyiFCeYFN+kNod5T39kDdD1U/O4HOrU6E7PP8O6O3uLHVnMQmyT5Bmb2CjWLFngr+9OMhlmE/RDNtWCJWBAP2vvx1PWzGJp+1s81Y51P73UqLaLLIhIAU0RHyeFF7g4xDmizaPCKZnwkO9rNPk/kE+0vpyrgEQrOPl2hwNaBdRMEAMYU/SO2Y9RP474jPqlxYOglEn1+zRzaVtqpBqkZU5yoqidOiKauLuLvl4ZtRrtMLLBXCZg4qxa0t6npzVhGnYbm9Nz2XpXFjueKqzj0hHs0krYNUyMtuJlatCn8ZDb3EO/eCSZRgZfgsxBIgIGOc44C3VohXNqWisCI03p2dndzisXsr/d4qOBBfUr2NqE02m4tmWSzbuGD7CezhVhg4g8eRxXKnov3j8rEa8oVhGsxds8zdHTu8ma/aaGTWtSgt06FZy+eys/N68RJFRbbvqXyFsJrwAd+483dhHKfYCTwO6iHQwPmcpVy8+4ZTeoWLJWp7EXqoBlR1q+7mRv2hTUJQLJti5KsWSHkqg8ecYE9GvDDo4+66225HFHvEHkSok41nUUAFCdADLUvI7OkBVujsdAclQKKcesob/RMxZ0fEhnDfG1hMNkeVbsWyowJM1TLYlKZmJ48CthLkGvHw35m4/Kq3pWy2bRV4pP3KuvSyYyQxX1gctj5HjzJq8o7z0RpIlt9G47PVhBTexkX2sL98xfHw0+FZyzn5iLHjJ5/xX+npWf2ZN1zLc/VDJCp/SEwlBC2TQLuzhHGANXX5vZx780idlNrRVGlVy+rZJp4f7PML7TOqyMN3xHX2sYRcJs1kE3PJnhrU6IPjmLOhZ9wcVowqVe+LMJ6K0xanTNNgoSujXQ+gCCaUgPQ0pjrLfhxhYdKqSymaeONwfRyNlu/YyzCmzr3ZUuH+N2l/n8Qwum+UebHdRd2jsLyX1TmqtMZ8mmLT8qdmbTztsrzNXOT3z9xu2vti7iZh8NTsTaz2fvxNLPpbEzuxjMejdsYRPiK5E6PW0Dv+5T4Ez6JualTqBJo9DpUTwOWuLDVO9Yf3P7bvjehdU+tb3+zYMWDNGq5pXYs8AV0yTOtK40WYjTzfarJ3ZH15c6C6GsAMVpcFCTCNOsP4iDFaBbnQFv9pz+tYpgGqk8faHPsesWg2N4nNyg15QW+cUaXdOPXwcsQolGVralR9Tb6qa6LGuoAFxYiMnurIB48mSvA6Eq+z/CZDgGUfTTWMBgEvTo6Vh0mOtnBqIz6FTeCO1vhOm+twBXxqQFETzrm8eghCrpc3WdaR9kyzsKNVn5g2wbVqgmXk6WnRVbaYFomp5E5AUEZUdaXlwCGLPzInmPxJFg2fLEpyGbDlOleMeHFZqtGY2VLD6+igDCwLvTW0To8/nFTuCXaeruFwS1eZadDeeWSeRbgYI6q1zYuLznK2Gjh2k069Fx9viK0+qIQ9q4bOskHdJMTytVE+cYyudVT8FldvJHqG+KsOtUZCp0/r1nDrynQ/qM7rRCu5n5VsBarmN2xgtomee4OribhTuhyTojPMFuJ/G1gbkW/pFgM1PedLO0QxAjcByzXG/5vqJ4vFGDNPOP5k39m/kWUYrY/0AyqoDL47BHRTxdT71T0t1WotV3pHAFI1Tau3LiAvBryXgiWMNnGLS8EvVQJeX2Wzffy1lyerqwU1xuSAe0+tr+UEKfeUF1h2Y4Pe9SsvW8fqyaNZQoV0c1denCUohFkE+lE91cqMgbtS8Z/yGModlQVs7A5dDClh7MkFgMRQGWlGmFyQh9jBDgM4i1JdJ+K6ieiRNE4pUYHRmAZhpzfdAzEvtnqnU+GLMse3tWANT5IFZwqYb9wedXeRysjFONQUhAUE0fxd/rRmbPuUemjzSTKJGVx7WTJowYXKMvxPRP/SMoIXD6ooQG02S5m8cHOzBwROntkRt2JAwaGpwRv3MmHZSTcEnRHxMC+Q1a4y9nIZPxYlEbqJ2DKnZiCeL5sqOz5oRemkPlApzcaySCuXCwfFPC1AfPem7hv5lx1CQihJBe1dEASX4YzIkDUgKxDCUWRpJ7Ncr/CHSRVLoBcFXfHmhbXQNOiVrkZZpegt3EqDTH61jAaPtbuaJmaF1Q8OyZ9KgpVtLE0izpGJ5OH0C37PDEasewXHpeuvWEzNXVKo4/7uXXm1QJ8lfoPFWyogF9OJ/o9oRy5D+RbciWQhFnsjXiN0X5ICyyaOawPGROHC5AqWodYyTtV8wvAQjygtEwhNwRlxhYMxDYnubDf1ssIM1z/iWNmM+gMx1x+IYaVnjoFnRtXCn3B6VP4soBG1+qcRV4zSMvHU2obcbKuyIVeE8aoxqbeCFyPvn8P1mYSiY5EvFCKP64BwlFaYwM3C3WN2Q3hNNAeF7Qmcg43y1PUVAFRactngZN0xKvuHOYhwKkHqn4sAcWRFQZRj4EsAK0TIwwaQNQJdRreJGVA8lNGIZMkSxDvD9vChqoMyOGcyUXPjTsOp0911Ojt7ve29bofTMyh868Pc0aJMpUChSvwk/rrEbUyptM+iOyfYtGqxagohLdlBVwlvzPYnUWESuavIE8O4zgmcBpqJwtD59HY/d7Y2e5t4hBvd7U23Zv0u6FIR1p5ym7BdrRo7FPUCHTlhRS4rBz5p35iPBh6i+6mxK8Qd3FbLrE6obRleItkofCquMRyvo7Nx4N3eRhUpehs3wqhBnmdACkXMNptglwZWaR+EzK/r9jJFl+dyJc3udtSlY5bzVBH6nkcc6iHhtHecv2ng/F1Jua5Nc1T5S3w/Y7oefgUpW0RUKFIssEchCs3c3e3W9MzY2KoDq1rA3a/RrTdGSfdL3xhL5xOCEpU1pcY1BsEw1RxdV6E8saY0BKWydfTo4HStZWo0qJJUFi9u5jhFwAvFXf44cG9cOipIxDakgoSLxVqDIIJpPQwVJeQC6ZQ1FqO/rJ9O2ThUUopql7JaXyxs0YE3LQd/a2RQE9qZN0shARnEF2CAoRB/w8M3VlE590Oh3yqzJpvcTePgsfHVLcVMpMHersDAnoPJZJYIMYxNR+kVdbFGkdHT5R4cFsZ4HLOCQm7Z3cQT96rXIEeXYWaqJEme+pF+EWXXKx1Kv5SjQGvuzXU8Iw1mHGEnMqqmZ84qbDiA5EXqp7EwH0ilPxtGIFtlkYE43KoVuTAHHSTjnGXjCXWICjNs9Z63SBAF8TClyeasAOiH80vYkFFM2f+jhZwrHKbpJfC1a5TlMrGYa7P7KmoceVTMhHSue5rD7gIjhIO6+dBadClA5EKBKv3HJQGVzrweYGDJ0Qm394ENUGe4lhkWcg33WtVOtFqg3zvYieoVc+0Ff6bcMIZTltXNlSPppkHidLh/WtO3yosmFmrVhAVUtMq7hASsckwAxwJwhzqMPqETGaZ4byhOHY/FprMDBjDHKQxIiBggsFFfRouV/B6Ae5kA9FvOQF5W8ROLKpE+iXw2qeFI2zulgn5EQYr5eWO+JaOdoDTcJ5SDLzcHCMXOV4FNgHfXIWikTOTUfuT100ntNv0zKskDMqVx24PlobUNXd9J4GWEY7I3s7aXA9bd3v7PKJONCBJH44tiXQGvHQVtZDI1Qt/exce/58eb7/7+4aetD/9a37k4yv558oe/+dvPf3Z+tAuvSNRowMqxciAHl9xfkmtAUmwQ7X5JPsmi4mHgaK1670vifFHA+QLCs3AHw/fwITT+jpIhVh7nD8AWjE+RaLMnXvoqP5kjww+zhJAbvk+4KzXQRbzMxDFy6XZAria0nEkK4E8pNER40VvmkDX+CE3SqHZL7lBdC4TKVRRet0SRLWUdyJ0vK3LDK+bQQKC+rIjdr7g3rleCGovlgvwACnuYVdZvji23cvP6rYWXj1VNZMGjdnN8TCst+KAOjT6pQ1sRu5XHZgACdq4totYrwl6D/I5mVStyaArq/MnFlOBJspyaK6V2EVwJoyTlSE0L9klHmJNcIUIp1CQuG2qRuVrD8jL1TtTk1oziUtTMJQsRmIPK0aQBz1jEmc5KNHIQjZha/Pbo9AQjK80hfzk5VqxZZUi6K1VDKcHSdkem2TXQujA4f0gpA92ljj2Eht3c+EmYTWGFX6sxed3dntuF/7MdAdjOvtlCzkf9475zIpnFMSvyr8w+rrgGLP69znIaigz5umQvbV5c9Qv3K7ZtXdM6x6lgKyS+xKIutnwrF4cP0s04EQyNBGAQ/N8CyyHMz+kvkaihxgXNQvqcZLB23Z6q3VFsQCfLtV5fbGQUKopLI5lhBXD8ggMH3EsXMV+KI1exl4iHTWOvvlsUlQUjThDPfnnfP2YM+6MdJe0/+IvC42CECEPnqXaj6/Qxst5M+uL1SM82TutGbBemv4ULnNZurKkUNYCyhJZdcR1YO0KEWBANoENT9vudDiD1H2jj9qb5LBYSNmoMpbiqkrr7WxiCzP4rcGHsM3Dpri0d8oMbcMXumip9jjCvBv5YQWD3j+kxdtCgxeOjUN95M4tCfBZu546BWE3nZbIiCotOKck/zQjHhKaT66pS8tJV8ikoA+DXaBTZ7ZQ9/zIs7qDw1Ck3YpB7qTfi3RoFR/9So+LIH0vBEgCAWiWnZ0ezSpLchPfw/WtJJrV+wpQn/OqS9tByYiLXv8MeWkbglLImPD8tWeUcqjh/ueomQHgq7qo8bENCYAsJJax7gSG9/oPnMa+hIyVgDeHYmyPnnwVwBoUP/4qmV9vtyJ/An2HhAw1+dpCHZT5JWQ4ROvzx9Mj5kAZhzArGtVk+Q6L1e4Sii7DbZAgaFqkp7A20lGhCAH1+4MRF24Ht3zEf/StwUBXQIUYxLeIfze9uqkdsxCOXixKTpR+71zHytlS3ci4cVjEkByGpWLpDIeZ/tOT4HNvFga+3jti2xXhhAkA+N8HIAj+3e7KoUjUqaEyWIeZBKXuTCh+IrZLmqerDVJJTMJ1hliwPANDmRgVO58pSeeWyyNJDA1r6dTgkJY9UdtDxsxkVGlJZoKAr0X5pXFnSTcrD2sbxg7zBKCCLYc0lGTNSREOc5qQAVIZGqPZPPqh8HMOFofDT8GF4nJK6wIUh+IbMB0BfWKLSkwjqvM9c4UUuw6AZN3It/N8Ab9qFdsYwUrjOBxFlBORvxgM7h2fvqao2dc/MlbkTDsAP2ZYikEsNo+q/ZyEbXXQ7WAmPXCTg3sHvEpppH/dTIeWddkVezUXKOptOISFPh5Enweo6goFK2mgHcozV8fD7PzFE1hwCUJ8DNdHHJyaSZk3Q0jkdxssmlr3NMJOzq8O7OTFGusI4Pwb18gX5MSKarwixfv+fypa0dFcv3oCrQOK+5MncWT2rwPAvnzhT2fH3mUlT2dD3LLCZW/jLWD7EppAIN2UAEWSY6Dz2TBROCWWRu2F3QHt19IZBgymnSDscgU9SvKRgFnJk0STlSDgxWiAZib/UoAcffms57z61nPfhGJ9APbIM0RNuMM3DLN8/9KXa/0u1/5dq/y/V/l+q/f+lqv3ft7C7mVJjM2/tWHlEBU0WQWheQ5Mzfb8qmhjtRUd7UC2D5D9OSatu+XvX0uSOvm//9F9RT5O7ekJFLUr8dGKGTtxPUdPVHTwe1VbSXEmuKkoaKWdq1FuUNHh2aVDeL45Kx0npumD1jLyZ7jAf+vuLF/BU0Qyr+zojvgoEO+eKInfpQbK6i5B0MyZfvWlF4MsCXkaEnWZ3Ix27o9wLyuTvcSYrCP+qLBSn16bZ2EuiP1lwtiIZktRM8qcoxzAMsBFloZyeYl1xOCqccDIt5jVxwecUNHf600v/mZf+My/9Z176z/yH958BahjM/KLBFGkxg7NYrzaWmPc6HTt3E+6HFzcbuCx1cTGZ0LTdp+nTc2EXAtVyGprO0ZpFMQsk3mEGgh21lokWfkbvcxUQrUfC1stuXQkhGbKeDbT4NpDcmuoJBTn9Z0r/Ic5Jf6RxHFLVIbYH4F86LKAmL9PShnWxSyMp7jGB+gsNvBzCnc4nHki0/u1lOB+nS7Q8FIMg6mIrWvax4nPK39+StmqOI2MxwiTDsHZCKArCsHpuqFxSjH7wkrmOlmAjqIWMpcRSM481V9U+UTSkDF8vy7xkTBE1oyjGLBQah9odSKGPCnZQ0G1CD0rBUS1D7+cu9eG+Qa8ZW3y9h6j/bFi6iUNS/NIczkJPxY5OiR0tURz2o6z2p8o51KNjWuJ0y9c4/S6l+RdRviLKf8dy/H+4EP8dS/DPXnw300pkHTRBjU+Mr24kwppXL6bBxJvzAgQ3Ku7FcatyVrm+o0KXN6N7UO7oxUPJ11rKq8QIZhAIrOhvjEoFGtTQYiE8pggh1WNxZ6hMuZjvUA078y8iDDOdZU3Z4sSZWFNVTvfrzvb5th00P5wBbThvFhtX+yKVsPbUqDUBrkIf00gkEgq00FUCJFbUdQ5U+ZSYUhwVzum7PocEJBy/HVJSthyipnjCaHP0OtzZDYLt7rCzu7Mz7PbCsNPpDHd3dre3d7Zfv+52/GBZw7J/EfqX+awp2rQvhq8AS+6Q5EUsaSMr+VVTSneGG73dwIPtbYQbm53dXf91sOMFW/5w19/dtHVkY/KGdnRgh3JQ7rFNBdTKgbwlqmZRlo4zb0LKawxqwAz3XqQCpXJyia5jUQesf7Qeopch0sHajg6VL3lYCJznuZ9Om3PiBXQ0sPCL9NrcMNX0UycqItews1yb4kdazjhOh15cgQt/XbeRcBk9BXs61VpYkPBR/mzt+mzIxRGwxbwxl8N7Hl6UAedE6jLk5GW320ZiNIFqTShgSgFCYkRT1cIyDKcnB/905HTv0eBBtXY0McLaB4BTOv08nwZfKfVcDJmvr1XpTB9WehGqgXtu56ncNZJFGFNozElLuaTFRWOrKC6MqkXy3KIKQplVxWc5VhUH1F/fD4E/Z+vjdL3rdnvubrl3E5Un85sC4Tu0b009tjWoyZzPn94rt5OUYKhoBea4S5Ek0mVcF1doVCVpUqRliEzL8hsUbB67eqPEGKsNUpWP9HobtzUcf8Tid8KQWZUFyG0owoSkvGmiGFfbx7JbsldAceHZj0y8xNMVth2R7StzqAC/phPQv6eX45YzzLCiTIJfjDE8J5nR1797WfXOw2tLJwI2KonJA7VnMfvzwJUyhX9b7j903lF3pftI/r+ycuScAAlG1Aeohv6M/3x1crimat0+K7F6/+SzNY1TeNk4LJQxjop3V8Ts7c2lpUTLGNpImBB1b+RpKtXTW6p7JYbL4lPwJfVtqCrgVN0OqJuzn2bTNLMzLW/ZZvPSo9pqUBUj77jTE88Mj75lZzh2w+qT2lpJP7rjtrbdDXd3uwMK/uvN7tbS8TGTaZONwnX5OFJiJlQljuu/AbXh0vr9RK7CabeppQs95hjrcvAXEZkh831HwNTCbJphIa1hlFBNKkqudLwR+giwQRiCy5P17LlNDFoQ22YjEkcUw5Bqa84V01Pfn2F5jJYQQjm/HvvrIB/BCnPwmlJ7afVcl+vWcnRYxQidWeGcu1Niu8p1btLZxoI5SI/We53u5nqnu46V4i9hp+2JF6Pc0WbgtHFCNPBgWaMqQ+r42zudDX8z3O31uvhH4Htbu9sbnhdsbAfB0p3uZJn5c7oGjxDdr3D9IZTq9KR/dHzmHv7zcNl9NOuhVpuqc1PfcXMrig5/+do/lFyV/i47SVaWS9cXqccWoze+utkRuJSFT05R78bDa6tcedRCg6riieRnuzsfFZmVw4HGtm6gnNG3SLU6IE/QQE4/jYIBgL1A40HhzXPZo4+ncqIiD2NMEFeni7uaRkxOqPMy6deyVh+5G3i5OgVjObllnDdGUGVnewkkmIwKbaAhE4QXwg+CI27IG+ZpPCtC2ZnKajQRKgHNIFkfuGs0+1kZMljsJqSyy0kO9O7KSg+o0p7Vf6+QPgc0eT3PL1Zazko7xn+jgQP/2+1gG2m3u73y36sVuJ1T6tVDSqm/D5NxoViOxA0cmxzJ8/oOFZq5yGhEWdNElH7EHeOn4QzLGQEWefE8h9cBbS/Sa91ZGcUwdSbONerB6vJjGSg8I+PKOB+IO6gXRNduo39HJMxILBjks3wa+VE6y1Wx5uoR3EE8DcJzLKPnkT05/Brlt1aYGqYp9v6og/0b/slsiYMVGRw1g1kUroI3RTYLV++5cm6Q+s3s236YFWyIlb1aa+JjDdySDfv8bD4t0L45BRmDO2vl+vaao4JsEQVmahs18sPSPGI+FDau0G6mK2WINiLyVf2KTNrU4+syeh4sNCHjdljT/+3w06ePn84/H599+nx6dnhw/unjx7P7HtmMEpuaSgg75eHtjmDoPabq3tmjqj+lnRGQl7LI3nCXVk8xvCUXZbH0QdccHkqlkRnq/AueuFdI//miQ+f3JMlBsYQKnqDMi5lgVoc7ppLC+lLTOnpOZZtljVWkTCE8Q3jEdjPG0tVHvfWE2Q8Ecz3NomAlkH25SbhBvdjjgoLcGF2KhcVRSQ2Zy9bZtiJQvZuedRa3XLy7wmkCjCg4X7LxXLPxAzWNMsX6uKUdoQzxRdFcTPDGchiIFGYMd6Fut6mFGUZeLBukuGq5CWKF3T5A3DFlHadNBc4zR4k4S1dmoBi5Buu4L443KwvvLFTbqYZMPJiNib6uOi2Ig/HIW8c1/3IzStQs8XdNqSFWtwNyFFCCtlwIB8LQJfn8+eighdrOBJYglBbnJ/gyb5l80DNq1E/wmuFWgfrIcvFcYVzVZCKncXXX+6D6wyXjFvKe0AUwG7MCOcp1QRTGvh3YyQ3LkAHmTABdxiYzPTk6cLIQ/dZmWXxdx14WPRtR5yTeHvUAQdUQ8Jjqb5dDGR2ZZYvQw7rWVZz0e/7m1lawO9rd3Xi9tbRLW9+h7yjmqF9ScUyctlScG+5tCQpRcf4Y/ZxwIBY5bF1EVxDgdgchKhZGnbHaoqJGh7Mhis+CSangbT2ZvNfcKYYrGJs2AHKKI7Vdq2tz9XpZZMEr506CrYYI1oeDLZ6iOml+4XUbmvX0Xb97w7S9re3mJobBb5h6q9trbmoYvGbq7yz4cFUyCA4PtTg+dap2fBFAwxElQjPAkLFJFNe59cqUYephayz3xbxzN/POMvZZDdkXA9BTGoAE4L9fO1D9Bl7MQc/fHLTg5P46VqH6Db4Yh5oyDtXD+8VGdBu4XkxF35WpSJzbi8XoxWL0zS1GEhefj+GoGdvQXUDxYj1aHlpPakS647Kezsx094U9oSHq7ot7QlPV8ot7FsasJ7JXLQ+Vaej+BYKs9Wb+Q8Kt9Yb/uoHXeo9/9RBsvdOXYOyXYOxl8OQvE5atdvSfGKBdhcN4KW3+Til+R1oJFful2GXDxRTmllo0DHF8VDrvKmJNy6u/qTnpEsmJKgq8WjSlt9m76+Kmjw/bExpaQm7VmdYvtXvHpZJ69ZB0brTqRpPQOlZhNKt6W4C2bbc7W+3exllnZ6+ztbex6e5sbfx2Vzsi0czAfXwon9HAztHBY6CBWOUjkEyxrNpaRDxLu3PXxWF2xjNVUmhtJdsC4hZ932IzGmstqjivlyvs4zzbfZAZqCDLEA3nI8quLva0F8Go9eE5wwzIKtVTLIikRoVYhLTjUF9DTHYl0SApYmq4nBiG8WXhPpviyh9gsDkF6SYJbDqquj3OptUqPBu9u0qHWKwbwHDOTZrTbP688ATRQSzRUUssu+ArZrYLwJp1D6spLA2Nv4bC+p+jqf6lVdT/AN30RSl9UUpvQZC/jDb6H6+GPkf9Uy3u6bVLNfW31h1VDaBnpBkqyfEb6n2lNTylVqemfhY62z2ipL8/hU7C59upa3IFz08ZWx4BHkFT09XYxhHc37lZWuKT+d3i2hJvuTYE14IgYU3Wj5IDyMLdWK996coLVF+Kqpg1Ja5+FMIO10pzrrOowHoTFKQ79PJwe9MJE5AgqfiuVcZUbjCrblDXoD0Ni19QRjz8SqFpAIyfMQpdfNeywy6pOkU+ZVxOdWQV9X/laKtBPD3H7wauijtOZSszDLwS8oYecwi3U4jGoG94wyjGUGFci44h0RGKeMM/Hf50/ubouP/pX7xz+FqIuRWh87ef38z6+53+Lz+/OevDP/SZ//lx6VJTeMTMNW6LUL+xzew+B2By3Us8RqqmTOOKbhf6+E7UhrG+csJpBLVvEvzFWciDdun4c+qTacTQ0PMKGWhK5xUC8/S3FgH18J8n/eMD+LjG525G8ag1RIXWgLD1gaj7zFOGf8ywfiEFsIkJCVFx9A+f358d0Vw0thwuBrzQq7wCnYkquMWUU8HDJjNq5kx71ZiLYx78+vHTASMufPoZP1lLN7DMQCIV4B6EfgRKDPad49wAVswwCMkZrHRXBmu16Sj7e1+ywvsCmtg56E9fQNP7Mpl70ymGpd0hB4UQq6EWLacFAAUw0T5vZpCCWsiI4Ly8Q0aJpdPyoqsmNtAfDrPwijt2kNYiTWE4X4VdvPvH+w/LLhhW84Br/A6mb3OJnisR5ge4DmNWedjpx7dnv/Y/HX7RmpMkycdnX/ZZ5viFbSxfjiYoiLyNVB1DRERuApl/uY4SBCDi1/JW3HLB1TttkyKhcQwz0BlB38K90o0jmlt3EF8evHF1bWsA8OUgHM7Guqbm7QUwjXU21Y2e5pC8udo9cqkVayGHqJQt4+ivbiyfpZLLQOZF1jsJPeA4aJnyfGSsGNM/ja5SDiCGkTAaHb4JfdyKXB/VzPzBiEmnB3Lur6nTj4TxK0chlhI3kjk2NMAnuUXO4f6pCBF1zswliKHZrEQ9ovhuT1rcYkdzG4yDBkSkKZjHC14XZYYwovU53jzMPhBQdAdqJ30keH4WFirwGyFk9t2UdjVp1aOK0Bi/3FKtmFoyilxjRCGiYVuOH2Mh8JYjH6Wu19z21JVdq4LzaOpia0jqMzQFdYPzAY5OJB2GDarVR9NBi8uYcV3ZRACNIOaJbpewBWC1QCvjeN7C4Fo4n4JqWevq0lFBk3lkPQQxTaUaGlPtdXd7bsftud2twR2KjzVoq+0DYIjmwxRw9IQGgHgAkEwilpCUOA9Doj+119RUZJaz+kfZkBp+YlRVLg7QJo+KmbC4ckVpmGoVm30mOXplMDnAyq0hG6sXYz/a4mKC+PSKc5Xg3VFKbyBCIcnkVqVyAWtLxzpQ/HyD8JWN6XNtj8avjGSCesAflnunGs8zywCq/vPBMdyqIJ1gZhfN0iJdMC90k6acmodTt3JdC/rObXZrYVLfahd3Leg23Lq6zdnaf95YDz+J35SXw4ewaDW3s41ZbNVW/iQ/38Aw8BlZNVH1tDVyzsgQI7I/OCkFSLxq0afa4AEDBy6DCxAZdzI9Bk41ROeKwqwk5frMtDGt8Mh62DiFkaojRmM3hxTfWdMxFi6QbU+SWrmoYBLl5L5COTdLY9VsBxBOPIoLI2Q/OjhdPzo51T+o7r8ttBLJIaecksgt6tQDsywWSVrwARCDtGHs9hL6nKaboBiOnCoPnVeHB5/WRBMdlSKELafvUO1xVlykTaHkMfUyMFvC0fWc5uEsSJO5ag/Ci6CbS38hwQTEQY+R0V9FnZXELIUZRKwt/DY1LFBfsvZ7WPsd1CnRGLwpD3dfdx5nDGCZTwzFGxTZZqL/ILMdCQLLSKmRQ2at1YOiD4x8MkUd6MgQvN6H3uXzKZR9Rop0xQ9Oxy6OW8KhfpNv4tS/hBsCMklekIA3nQ3hojkHx6eca/bu7Ozk1Fl3zt6fUgpk6qdxvjSnCBrDCNrj0QGTKcyw5zw8tC+ISrDU7oUpJ5NJQ5Q08wOZPNYizp0QpttZOviv2b4qpnYUL2ixspgyKNBwVhhqMh7IE4vbXIgmKLL5yRLbb7AvCt4I09NK+ySLnMzZXu5evP+4/49zuATneAnOAfmX3VvTXUtWP1mdSuD0vNsqVJhnrU63lhtYDiIcHgV05qnCzsk9eFdXc5As/ZnOALZnIy0LbyY8aHgyCo1FLdQJfMOl5GHS9yXth0MkZGs48hIxCIZS1bAcJqypgrBTNRjKGIswca+jy2gaBpFHTX3w0/q9jhclrbB4gpsrZmoB/OESg3ZJkglLBOxPllwXtSe62Xfi/ZxSOgl162rTfiZsmOcnguSfv2Upa1k4zWbPhPaTOQZgJiMRdN+eMVdakjyBdSGDGWAA0TLswCaYVbbQ7XT4f8+jbcyZ0WR13UFDb14WHYYh7ppwh4wdovqRW9NiZjkFiSFsqkin+psblKS+eA4PWbYr93LhcCH7E/6G4WNSeQCFIxHHM1KCOqs8aI8HlCYHTkjqCRy5fp7Pfxixn5Tp6ShOr8k9lgVaY0K3yNn+iRi1xfimlslr88PoSkfBRAlgEwx3+q9j6k4UFq/yNfGjrKIPA+q1sO+FcVEJXeWZBIGM5xV4/KCpgIQLBbV5YnAyLAo9CEu0zLiSgWi5iFnmzooabwXpB3E1Y1i5iqS08Nwl/BI/Cy1REO9QduHUzEJaI7mvqSeKK+SlKcx9CAvIqTUB68+0ix9kPzdVV4WU0N9nia+7DrCxULxdN5gGLTCtypAjIsF4jBw5WFap93n4dbkF28XFVi9g2vAztpIHYZu8QV+Jx8JP4VcOK2xZRD3iHulYRQoeg0sL28VOXrrTekLlYzzLlCbNnZmaY4SKsxwzYRIqGQnbO4XnMS/QPxiy9Y1rsXCTRtSpDdsrAQx0cN2WEHT1LJ1m6ECK53dRrtkY3JTgRFjPrE8cjLI+0x4UgZkMo/EsneWweMJmekdXyUKw5CpvO8Zmgh5agltAhoS5jSzEwJW+woOIJ67j/EtDFkM35znb222W7V3LNUm8H7jiiwGDzJbREpSitKc4mMm6TWTJRhM2LmXg8rIG2G4crd5U507IDOhI1oZEZKelCBwvd5MZCgkPCMIR5WV4HLS5p2qVwqCRJukEy9yJvu0Ed/219qSLVtM80Kv+6fFapaALBf6CSqItTQxKjsgMazj0Vnd7t7xn0wzjfm+tuY2114e3/ZSmY2D079/v2w2Eq9E0y0Remq/ZtQEpboaKkFBHF4Oui6NnUlw9kh27szEj8AOcqoLG8zi2kXkcpq6P5ZUaKkO3j3aF+jb2aB0NS01eaTnwQ4TliJpa07FVEk9MVlnfcZoBt+xTBIhXs8gZLH9+HuVpTRGcxwEdT+EcnX6kSP3KCvf7C5fV1GmKJdUe6D6IykEVUrLJ+C3LgUfPSdmum/c9XDtg6wHzX3S74YeqAfd/nBW4oSt7Tvv1hrvd3dzZ6LTgK6+Arza33K3O1m53x/nf1coiHyGgdvUztjWWfLRkmPRUr/UWBryTcYqkJ/htDNIpCFeZWeQSHpiDZIIuaRQXrVJcgt8VtrEnylgS8sOEHQIUah+nHMY0RLewLKckRVLNWXh5sTO9AFUH/2CDIGgI8vqaQWLHaYHwwAdZciZBExnWhBgbAFR1lq9YJYYpsP6kHfiVM8CIoTRp8kZ9ohluulDtn/cXrauhKyXWVHujfp6Fw9C/0f1YWUO961FHG6j2vswTXh2dXG2inAT/3V6zecPE8xvY8If+fv1ayjW5C/cBvtbVM9T5hBZESRim1I7xq4Fz3D9TyrAoABYJMUlfRKx9GF2h9ejgw29rhgBqXwBSreLUg6vpxV7i0xU0fHXYZRkuMnxdkjBxn5jF9NhJBSYAKIXs+YKA1ck7iF6VTsDw9r0ErVL2S+UYHph5I8C+CMU5RBJLbJ7XiXh350BnFKY4voBjNgaXsOA5WrTg6RS+l0ubDaVkaIVxCzC1jBBfGk6oeWgIWBmlqSuew/y9FdTUV8wvyuWg2XkpAp3QmohF/aj0V+hHOSoyojUiqZZxdClSeNgxl89Go+irGpGeoab1e+vr/Ag/gQrMGuhRHGqElgXUyr9GE2UFHs6xutgU7UfepT4/VkVjD8YF9Qn+GIZxzlovWvBJo6Jilbj7s/cHuYoKXvFTd3a5UmV4BjTsFCcJ9sb6i5tnS8ithJHRDG/tH2hFoWqlRqCMDGswhAMdtsKhIuFXP5yyEENBDPQa+9hsVBFo7TqgfmK4H1b1N+xUTmUFRCREwWH8n/hdhD4oiYlEGwwUpZkBmbShyrHxqmVAQPT1zKsbwky863o0r78T9r0xYbuCGa0h4I87mYsRGDH4ZsAPK64a8UiUVuZRMDFH1jPlvXI4u5xGR6CvwHc9LCDetS5fy0JivTyrMqbsbqrHWGnxnUtSNJRGMV6ZKWg9aU3hZdzAspb8Ip2e0zbOm8fzcDQKqdo2zioQRez+VQj3dK3FvrbLJL1OpJHVWpYjiEtL2rGJCCDKSlwxLolbJZDleetyw/CUCA++b8pIVHERUdQnsRx5pO8rcW9usyhjWgB0apiKiDOcg/DUIrYIJ/X+oH+CJKvPOz5QQ5m4slrdXQi/xg/k9qhuOjSQFKer4YMuUsnz78yAhxtbzTWBJ8X1hgiMGI6pcA6x/nwoUMaCAdndvxlCseu1cYziTTbmdl5cIl24loXnmSzU6zLg0V20zgZjYMyT4Mmqi2iyyoeAFNERitLGsFbVC9yMUeFQeiY4GKyJUY7Rn0YQI4NQffzMbVTgMgxoF9QDPBMfcHcDxdzhvyM+q3JcTBLUyEuovtUh1a2FCB4HlcRp0ZTVRSxhd3kulOv0AjU+Wb04TsdRUt2cQbo8Il3VLWdp3Fher+q7RYhHM0mLJBVMEOtdGCF7GQ29xDvHKOcEm2BlIUm/yfic2mLdFjZrBkXIalhGVIT86sZko1C+XfGxF+ZvHCVMFgEd+oc20h90xx8/jWMgsKF2LcLwuRqY0jPQLTyKMF0GL4+6ynC8ubjDqlGBnJvS1DjM5A4u4HB6EU7Q9d5gr4tDOUflAmLuqlj+K6AxmGLL3bHWKi2dAromZPlhb30u+zGAGIJFNnJudjEQAxKpCtIQK/DXBKrteJujrU5ndGP07KPQntWFxCebJQkHx/CKpW4mQRLlVKUmA0k8MNt2UPJZkgahsLhbW9bOaVVZghCGBOcgzGsAK16p9OkwFyMy3CfeJWa8gZICkI+GXF5C4adWBRBPESEnYZFhOgVdjCSsYK2dwoUXhhR2H30TtF4d6zXBfMPAJBSmc0BETESca5aEoh1cGOoXcr6X1jLIlpBaYNcarRGbwSkPLHLANAN8T/A/ZIf0EaFPAqFXo8QGG6/DrXA4CjteuO1v7r7uBcNwd9Tpvt70utsbr4fDnd7m69H2LWG7j4ORprwikY1DWgzqRNAqRagmNS9SjwtxM4m+U6KewBeMPLjm4w8wNTuCK2ogsxhD5L7AfUAIK3sEZYPZsgzbS2SgEdx7SsAnu7KRqK/MLMbyj/hbHx7FHRyiVgmYyRly1i2SYo1puWCDLvYWyzTbVkr5m9Ar8rpBWLUVbIma1ExVNRD1KB7koNTtC/AYLgbbxowWPzXGEXMfbXHdbCRCr1ODwq/CJk+hBE1ZojMGJqCVk2iRQiUcQb4sqaIU7/E3uqZGLLVZEYfS5il+hdMQW8YhyK0rsqhdiEPZmEjbHJmdqJXJlFM52nK4VCLJxhKqGFVaAD7LZ24E1tqIKnDQxSXg9DJ107rJwPSS1VUtX1I9PRF4QFZU2pyarVWyqqaZXKRI1DMr/5keGrzRcFKzCDQOeWr6UtKVRn7hgK5msnrB59Icl+qYaoGolyLgkqC3jv1DiiTo4UtUyMYaTWAk9qzBfSCqoGAsNgXMlKMx87BGTJDztTvin+52uWZY0UjQA9cN4PFLe7X1lYZqapDIK4P978wn6MVSvUhW2mvkWUtOUBzaEMzlToxJDuUBASrRIIC0cgyMYLBXV76hC0jvtZScBhZVHdxCdW8PJX+cE/nFrlAqD0TFo1q6RfVUNA2Gw4jT9BJVME+koGLIPnaWLOkWRlFURd2r0Nhwe+6mqWdR2KqlZulvbtCy+CmpB8m83EoMM3pdA5aidRi6jOFdZ8euW6dZIWIYQcWIGJ4d59wSfmEzM4HiVwVBNJ2uvCprEWbMt64pYW7KCJy+JWTa9JuLuGm9p0WRwcYsMGAOtDQTIjOKSNTk0Sg2xWGxPyiWyjHFZPlM7H3niyaUYLCB+YNR11WGwB8ZOj77RdTYUjPyPTOnUsSM03p17oLD5gMuelD/nISC2iXnOylwP88AaQHflwDplwDplwDpJw6Q5rsni/xp8vYEUdI89UuU9EuU9EuU9EuU9EuU9EuUtGRHzyNKmtbScJS02PAt0cHYb4VEfdMEJQOHayOEjSxZ1EVImQU587lHTC8Eh/tAeDzDiOnlJa8nDJuuwfknC5s25cGXsOmXsOmXsOmXsOmXsOmXsOmXsOmXsOmXsOmXsOmXsOmXsOmXsOmXsOknDJvm1pimO/9Mf7PYnb8iulDhpQJZJMeAUhGH6VEfAKqW7flY600KOGIuEGe+op9t/kWs8IsSTnDDH47OPh06/bOz/7P/D+rJOMpgodR54EtS8fjj3cX9WivRA4t1sANbaRtRpspys83l6OC05Rz/9PbXFhWwXpMhWhjTN5kgTRVLdvXQFAlDG3ILdIP77t9oRapRhVl6HI0BQipVZSZTqV7hGHpcXtGXFRD9YNgvK2uuNVXoX9C9hdkMMFQmJV+rHvQSo81R40QhE32VWOZR1Xkm23TBkSU8T4sOzMcA5RgDwIiIp0DDaHV63C8rRpXwBIkcKkocSoJLX1naGa9O+QnYkcBDNaXuejvLuI2trI/Njg+JV5YEzodOv6tDUbHfdBcVNEFLUVOJsSLLOeNIdUP1MKXAUqqMiSHkosY63DDQTbiZVuFgh140j0Rc5hwmLbIUg3tQYDF0+8Ibj3l7srBe2SNo3DhbcWS8bkyYWUHEjtj+xdC0cFIC71+iawhWVPQq9OGL2ugXMUrLUvUAZb+6qnStV8Azl+4kKrKQStfyK/n6Wb/T6fTWnbWVMnj4lzrANCg9rVj4KiP1lgWSCZMKPX04kKowsvsdlcDUdA1nQiM1CTUxeEbAMoevAm7ZUWy4KibwJFdTUbeH3k450N3AKd8CWHQ7W7s12EffL4DQ497RbybRrlgJGnc+EfMYTOxu6kT2QWzyRILbKe8C+BhFRE2xb1W24LS+EalYGp4mHL0aatEUPJd/dwFg89nwqagG2YuYdJizPpQSm2M9DLydTncREXE7y3edWADcZ01wFtOUOx7VjWSl6aM6Sa/D7PQijOMHntW3ITdLg9oEbz17bRzUd3t/SZODqJgt7Q2iXcRdOl571EDHrPBuWQZGqT/LpS1Ut6OQtd+xf3oYj4g7RdQ5lurTx3PHu0ojasTVDsIpOnlErX6t2PESvrpbnV0xKpYJZ4WOguzDO/Tc9qPpRWMt2U65azFo/qRsisYLPCWjXTDL1NciJckAaYVAvj89P9w/eHd4/um0f/7r0dm78/7h6Xm3t3O+/2b//PRdv7e1vXSLK66fZ8CuISicHH5oy97amO0VtL0YU5DMU0sp2VA1vRJrI2+gDlXPVWrHZMYl/tvhV8zYQ/cAoMeguqVz/wLD4LF/tS+cemYLV4ddoZwTr6rHo8ewRkU/cl33/sDllTRtyTRhbUxeyRa0oK9NIBeUyrD4LO51BjoBTJ4CLIVdvHYy/SjK8sJCC5kZfKGit20LLB8K2l7FX3fo8MbrRL+EOwm2GjqYfYsyJWMUvtFUp9uwfDjYcoKI7EgAvoPDT+r87FQ3qkywxJV5y+mlOcZuJL7wlItWnOiD4c63ZnyEcrjr02AviW4ZP5tOw4zScQle5ZPovH29vf/6bW9/a+vN24PXBzuHO2923m6+efvmbWd/93D/PmeSX3jdb3YoQEm73/2p7B5u7G4c7G50N3bgn4Pezk5ve3u/d7Db3ep1Nw+6B939/cM3vf49T0ezmm9yPjB9/QkpGBrJlQ8/IT0qn9Tj3Jvtnddvt7e3+52tzcO33df9zs5h722vu9077L/ZBJbeOehtbx12D17vvN56c/gabtTG/utub7+/2zvov+3c8eSiPJ8tJevcFGtxoJPSYZuG7vI7CC2qPxDNJD+RqFbLcEQbmcppVIwZxz+KjGTnU5oWzn6/5Xz8/ONRMsq8vMhmPnlczkJv0nIO9n9UUQTwt4w1XB5Mv3sbjTX6Yjc4VVLRqWs8ryizgTLzBYfgzTH2DFEKUen09P26lqOx6EASwC28rEaBBJvh1rC7E2wPt7Z8wJbXvZ3djV6v6+9uD73e5l2xJkmLc2/0/7f3ZMuNG0m++ysQ8oOlWQoidbS6e8PrkKX2WGv1sZbantiJCaoIlii4QYAGQKnlp/2N/b39ks2jLlwSeKl1tMNhiyRQeVRWVmZVHnkrwRnayS0KDXy/dRZSSKWxha8xm1FllxY2fawRQeHFMnXSbN0VGA6r1sB2d7u32cV/z7rd1/Sv3+12//u7OegdUKWLeyRYmT6tie292u8ug1jO6O2v8BrlgFKRBWU+oxi/O1a6M5dRVGiMxcmpuoU4+pjVHoCKexhDxN1s1c22cprgT9/7nTOXjXrGhwtdGllfO40jkfOTUOXQulHyKou2wn+KbOUaBX6QzMpz1pX3oYcrmtdqXBv0cpfmHd/wb6RyjwpNMpekcTPYJ+m2ts++8coDPhSYelug4JTzN3jQldQ5IA0eORgn/b8fvkWPfOflLvon9kH4722PmnlZm8uf+QwT7ouIEljwFpqW9qr4eRKy9aWlzoGrwsnXTw/ebfh89Y9wcE2mN8jvOnOAu39j4h3d+TtiS/evWH6Oo0E4+Yjiu2x+F1pl2OXZpdjz1nEovZAyDH6Gz4VYUVm9j//ub87ynmsK2ALyGd1V6lc9BypMhhTB+uE76saISKAku5w0PParN8hsYaFx7f2M4TIH8H6KOUy629ThwUK8oBTalfOBE3XXDzco1TErk/nxdAEahq7W7S8hd7ZGja8fzTN7h99/PAUNbezk4zgghU1blY2577i2dM1Mm3WzjBmn1Fqb3LuqKddgtM452ahm3E9ZW/wWyusFCHJLRKyYKBcU0PR+gQUNorAkmkXUn8Zhfo+kiwirfeTIgY9zsKAk/QuwgSqF9ZO0TwFiq7uwMnsqVyZLPQ3P7KhnHe+Uws0+bFQLEUQheC5xKOahdBmeHvk84N7a6rwtXLsGLwc8OnBy9jd7L7zuzuve3uudV/9Grs68xC3s1t1JXdmPa6Ss92qz+5Io673e7b7e3pufMs5p6oOw9UWEMZP55Xhlzpwav64Pu0nAgpErFIN5vCBtwRQ2pBW2EKfxnctg6YHRjw8E6idLnWf4XL2iMj+ZKm8VXsSwxCd7270FGSI/T5LY5qfPU6XpjRrCTCcIbXhVmUxz99OCuBd7ezv7ph3zUH4uhz/MR2wW/iUXIJQSgDGYWDnGzlxmExHQTdMgrInM3e7uvpwH9QwYCbtl6zpaC6SPMChdIYu2K+vR1u6S5cNu63TqQin25CSaXAoYGmsBdYq1x+xhN56VJ+ScRWisoIdlTr7dy8VUBFT4oMzkvb2ffvzx1eH+0Zsff+q+etl9ddTbPjw8mEtjZMA7gcfBK1eGx8UMMJfVBglXU/yOwQvopknkT+bmk/LWfgFWKhWU+XvinQiwcQ7TmwkWkwwHKXjN2NddmnCQEQw6HaBRszVKInga/rc1iJIB/L/n93a3sjTYCmiALWQM/ccfJd+e7Ozsb57s7O1UpoFvVTbnVNXqEODLuLyZ8Xk1GmXiMhA/OfRHwCERGZvQ9kSck9Yv4dIux6PVNNynS1tWSfrgiIsuNfi0p2ffW7u24518fypiTOeIgzALEsfn7aCn45OHu5LZfjDubIEBi1D0pf3ZpsVamNBlEfgAnNcSvXOR9AwcUXVzv1rrySn3jECVOVMRxZ3WBKzQP2kIJLQei0lBp4r6fL3R4QtHMaESsXX1AjIZTGDHT1t7ItgJZhCRYm9B6SBJIini2jKh/JN3EYkCWargDYaWxnKUYPMBqtgsqHxGILMM877BwLS3gljgK8SnVFxqDLsJ2T34eRrHMmq93GIgo69DVO91Kk1c7EDSV4Q3tl34oCoJcdiJ5xRToQK3B+8OVKEetA+0bYinXqGIBYUJY7LjKMY7u2wrj7JNogQlH2nY5HEbf/A/X+bj6FvwAuJNjeNmiPcoxVAlrvzlOAcRBohTCwW/LqB5C0zUtrOSygz8j1UKHBBQDGYmgVNwKXXZXlHFHC2K75aktLWYqb7RDzLyVuE2a+RtlaQvFXnbhMkjjLx152KuOXiYkbcKzycTeaun6TFH3rpz8jQib7/krCw78rY0O08k8rblDD3qyFtF41Iib09nirGtxNYWmsLVVOC5nxhbBfwPsZPdb5AtA15akO3Oq93d3Z4YvNjb39uV29vd/UFP9ga7e/uDnRe7veGM/FjW1StYeeNJJeZUBVg+hCBbh96l3MbOQvC9B9kqYpcT8HnaOrSzpGBrFnolKGhlC/1rPOKXi0d0p+C5xyPW8uKRxSPW0PA1HnEWbj3ueMQagp58POIdND+XeMQaNjzRayCX0icXj1gm7unEI7qUPbV4xAbanm88YgNDnmY8YgOxjyEe0UX9azziPcYjFhj/NR7x/uIRC4x/4vGI9bQ+rnjEOhq+xiO25dTjjkeso+hJxSPeReAjjEesI+kZOKKPMh6xeF2+9GL+bIIVuoLpa1/4LlNxU/Q9GByjEIWPo8RqLlr87e9mJGvVYXrvkPsR9qDhUDa6SjZRerSJuGTeRaIuuHlnZU6w/mNdHbiOpipFDfTUtuKpdppBeLrXBn0OEq50j2oiBwNZmrY7B/xwKtVFE92zw9OpitHUDTsoIlME3PJZ9xUR8B6WKs05RpOu97+x99FhwB0UBLVyxjtaD54GG5Plwkr/xcUr8fLVy95gPwiGe+KbNsVOiYp75GmZbfSZ65A6bQ1VrxbuXmdZpgLGsA8jlbYZSWRVscveN7p3NTeuUYzFy+qIXS0DhPrVbqrARowCZF5nZb7uDi5ebV/s7O3vD3Z2h+KF2Ankq+1Xw67syt39nRdFdmpc75mpGmxreXXfUa0MdU9Y00CTWoKMpcimqfIcSYiNUCoBNix3xVhvEiVmdrsX3Rf7QnQH4lV3e7DvMG+aRm7h3Y+/ntxReBee0CV1VWcST1XLYScP28NItR9yT1F8JeNrRfWkRh7pH6SSWhl6Q+z+COKRAAsvJTYw0a01JyK/VO8nng5rbVNLdzmde4+4u5tuFpVGTrPPYt0lt2/kcQzYUgfUDLs4Ed/G4oZLP6u4cLyajYdbyCrkHzeni2465rxAlBtWcoPLY1VOCsfmDpdOU/FrOn4YJbrJ8rmqGcUcqjZ5rCkdZaLrdQD2KtuDcgdU0wCWoz9RHWngNfu2knnDFpoUCj4d6riwSIohi1Ve6OZZGR0rOeN9eSZzPN0JcxUD3MEJxh6aQDT4llio+5L2jeL7pcE1WG7SCnoPe/AmqD51895hTYNSPk2ih+HBtUk8cuou4etrPn7nwHqX5Cp89pqriym6yBAodKs1mGJnK+Xn5SL1R39tdIjyahNRDMm2x1iqf9TQW18b/bXWYXx4hLWNqjxN1LGN08VpNG53CjuXDH2wDX7V+vTojoaF/9tzZ7XmyWStNF/wAF+6FPvJaqRLnfewj8Ej6tZ5fMGdF1ABU0+wcIyKRvUFu0mmVPTbqpEbZ9azPHEjkkABngNrqWnpOSXyUDwkqSFewWFGp3cxR+xgSFuqI4B08DZZGDbsz2m7XlNevqiXXu/u7mxlUqTB5Q9/fq++58/fwrQWZkmriUc0U2BmjJMht9s2Wo5EGQMFZVzgoOFcjTbANqQyZxsiAdc6QeuflUwyIOtgaLaigVTtxvEbmlPYizN3ygVlOGG3xIzHwFep9D3w0vtjSr3sbZgXqUfcrcv9k42EmKZ05jUzLLwMuhfzXDSinYI1AUu0qmzmEhYcreHnghxNRJY5+mjpyTBqeNtIgDY1v4RDfrmgAfNBYPhyAYajExUj1kpgk3TGazM+HXit/M1aPBKrXyt4wIxVNAF8V0CKHLBVmiIEQAkr/zqQmWp2j7+opLI6GmyIHvC0JFSVPecH2nPYXnHPJVwoPmpvNhuNbRQn+C6txFS6d6rwg4O7r5rXp3xBRfAwNFA/1XGAMbFs8ZgRuZl57GE9U4sPoc5Pnqu3VYtCczkaUkA9traFNTsAPQSqq9gh8Tphi7i0sXLKIAYE9JdjtJ85rpUdnFSqdiuQrslEmvWXTQf8U1MLaJ50M5bq+oxez9pFkrhhNGvUSd794vF2lMcpo67yWVNb+XbN5MtRRuaAiby9VS3rUxq9Wf3RBlI2rPk6/Px1rWnI+DZsRUXKtECtUmcZIKSzlSHM2/oNrFI8Zg6tsJJ7oByRzMl81G1w1dE1qCY54a0Zyx/za1NAOi0tArVaffJ3hfb4HZ+ijAEdqoW656rUv3PrU3sIkuumYQS52hXbrpiOwwGjKCsE4enVdf0Crl/tRY3g8pZ9flgZ/vhGjcAiz2sefljz7SmAajvPoxT8MqI1U5ceRidpuYTvtvFGo1dQK53C8rTosRZXxrsO/LZjrPGJAm4AeSrCyDqoNctUZK3vAMGW6hMZ/dXLuYSthdrYIlQlKIr6dQkaCAPXMYH3U4znSKpxdOn0hJViRx+9kXpzl7azSGqc9DJctxe8q91IDh63zid936Tu7Uy0U/z0faWR+grDHz6q4WsMaxeDwrmn/tx88ElSSOfb6vhTW4igT9n4RQUBqE1z01Ve+WTUp0xeCeP8qqM51chdfalamqF8XApMoY4l3vtjW/DUOc6Bb0Kwn9g8JCCkVhJqK44XIDGeVndsfzM6o4UfBGWGKy+RdwBHUY7VxLXqUQZwRoDDale92+6Yj0axRbphLZm2Y0kxXclFk80GdJ8cHXxAFh6w0B6Zodzl3r6mtqKdMmQWtFJR6oqpNv6saOAmOWdMyir7SCJl32V2C+/gnm+aHlQ8vYMIZjH33sDencswnpUJJK1fTBoJ+pcWR2bByrq4Vm/BTIEfol71VeSu9FuTSOSoEv35qFjh1uDOIgObFUUnA3zpMvbR9P9UtQCokUjKHSYL28wFXU2zHozx+jq+GWNsgGuTjB0hhPWIdYpgEZ7jSyAo5yiD/AEJPDdmI/z/gudZRMWtDVMOK5Y4+v6zi2tZUAObdrBMIdV3ApnNOm2NYlUKK0jeo0I9vcTTM7ZqUjyQdW4ks5qcWkEadVaa0ySSK+91wjECCAkzRciyDu2qVDZRtcDLp3AgYtEXQzCascxLKskpi0d9HHCGci9Pzmox17XaMH+Whpml/gubZhaRZ22cWTY8Y/OszITHaqCV6XiQJppF8quRtoiRZvn4gM00i+RzMtQs1c/CVFvlTu7G9DyMTbp9uMgSdnCN51PdnIv0Pch9t4ji/W+pGv7X3bJxt9Qs+lIboSkE/aX3uPa6aYEN0ESDPIe9LRfpSObP0lVXpH9hP11h8ayddMWDZ+yhFzjwWN3zAhEP0jdXGH41NRZxzBUTH7BXrjB8Ti65IvkJ2yxu0ExfjHQOhxM649lvWwTQ8Bg6jCamxGYqWDqWHAstvEGaXDuppGYtnmGSCmcfZFgvGfeN2LuWA50fSbkKOBQGP5nAapUZPTWo6qDm9jEvQ4nD35dyVdDKcxl+uExieYdnsBKELOuqVXHEhUjDh5mBU1Jxjhz0C3JQrYf5VxhFYmvP73rrzPV/9w4/fFQz4L0/9Xrb/R4H6b0VAX7xjw3vYAJv/y4Hv4T51ovunt/ze3u20O4vP5+9PenwO3+XwadkQ9dY2OptA6C3ySCM5FZv701v96ViKwyzqzrTGOZm/oUYh9GqUiGAFB7fW9exfakcXoq8A88NQhF3YGFJOciGGFYaD2GlblQYyE9W8H5c+XPvuZYA9hEiw0wb4rGbaGp6C6RUe4jNz4o8sYi8Tf4QV7LMlU8yjeWq6kZUaGBoBm0uhSCum1bCrr/rdzd7ve1NqmQYBmXsH9nRacOc6oxrZ0abJvEfZQ5oc/2+ZlDDU+szAD2WZB1vOpjG+fS2NSnS67CyJldblqWCfFu563X9XlnzrRZVp97NHTseamvHLrqKlKZTFtFvJwfv2thC+Jy2gjA7V6dRKRv8xnvZ3fZ7f4KFOVrPNjivYyKCTzI3BTcyDsvG3Ml4ROn/VL2d/6TxRZYlQaiqSuEQsc6vJOeHvCOkOk9MRQldulIBY82mO5EZU+wdp5n6SH0dFZgMnA5xOEAtUtQCKZQBQvmoU8rSpvZnTkEIKmwDiP65Gcabf2LvPDHJpowlSDn7bnWYeYXUUZCDMHBSUVQgNFUjESZHNpNxBoOsS3/ke/8t5aeO93sIzLsU6acNSgANrzAw31jM5Kmn4oIqe5Y4EQImaeOs8hAeP6SIsxOcees6xFuNqn4r0r/RQOTt5DF9atxZqbyFPE44UeNSEQWdDIhu9XAYKsnS+BRkBQWdu2RIzQ7gw4h0gRry/UC3q3GEW0uv70q56kZYI3/6cV3bR8u265tTCQWzKlQdHO3RD0NYt5JOEMorTI1JGDjjNc3LBfD8Gr4FKU5J+LMOO6QCfhYRVpdPsxlckpWdVhFBx0ds+aFI2HqphvtVfd26qeXqPJb3E1VVjiggj38WGmBSsCx1O2/4ahphZWcw2XXFQ63+Kz807wO4DRQGapFcImpAe5VME9161J4JtMrsgNU4SlabZU2tSjDtjQ0C1OdpcBnmkvu8ECF5hS+CIh8y382kho1C1THQJtGmWd/rF+6x8RG5Iwjr9OPpmw38gwtzR/SgGdS+oKt+wZM/qXW7UUgKs91NMYfxJhtNwbr1+W+qOvvntRxcymiydZH0qexGtIXJaZEcjiQOvVUgsK9Yj7k9l/n4n/9FA9mOQAVm2Gf/tVFbakGXktFpP9WsrO/+uabpmuHSKohws9D5mqssNl4AZAr6FbiQBUlqLcvC5Fin260QQcXnqQdtcJVlW9WijL+dtq4U62C8PDas8vTjjI6VzBf1LKXFp/aszGzhsEpwN3Sh1b3dsDyCK+mPwzyV3P0XddjWhfiTxDz6Fn7tU5Zb30Eu68OWCzI+/OchlTA2YF3dik3UcC/GGu1YLA2m741L4b8q83scg74IwIXjPgceGFjb/ouOWyuhyA5VdeXXD4czNHyVVA181QtEa1HnwN5pvI3Zpo1TU10cdVNUszretGXByiwTpFxTrFTD+vHRhs7oVSXeC5nwdZulx4mVvnfs5kJiLVr3DkQBUIPqC7sqX8u7R1vRv4bF1g9B1mEJhMMNJetlGXc6PZdk/fjoXzVztMm9M+CfGRpaU9mOlVXKxZbgXLOoWcEU7GelbbjoHzAwHLH7U0i5zh1ZwEYihXlpKhRUnJFgFG4Owhi/pWM7+PwD/vG94eOLXm8GNqLg9Vcq/MqLBPIzTBSuFdXabiq9bu+lP4tQ4PjAUB9YM0zSFZLkpmqXN3hCwWMUKmSdwaQNovaNM/AO0h/Ylgu3EXMBXlneUFEEhuE07RSzpviWqet30eLuwf9VsQX8EzYafUw8xjoaGdYSdAt3/YgmZqZGTND7RIsNW+5lY7ojI609iZIw10wZyzwNg8xbF3kugk/AHIx+sJUhuGbWZ3i0AwstvAojOZKq/qa68gbXk4uQbnSwJBz8bUd1L7BxDKccGNicKQ2LQ6lQFMJJNQ2k0qcNRkCN+aVNdRLdzWESTJHkjYqluufvzTbFMr4K0yTG0VpdQd3TXL9x0bpr0kUMM60rwpGUqBnqePPMEF2kgrmP42cPYIrAXgc99pBm50xhdNfE0B3PGHuXEKORpcPQqV7TKezXeq6C5a2Llhxe7Vk5OfLvdA3/womHdZ3X3/0GppnZ7KnUT07NT53Wz4AnyaeIsRQJHVGvnSTXGKbwFjg7Ha+xNK9hG8Y1mgJ007wrvDEdGPVpRiRJyMoHkNx91sDKCZQdawfG4pIxN3SGCDzGyixuKUQcwT5cmCNHiugJLJB4jYVdyHoRsRjx2dNPx7+envnv0xG3bfDW6QtUnt7H003uAx3DlAPHLkLH1XIaJmCVxgSVQZjp4rVAHZ4ykN6nE/VMBiScaNmSnkDrawLWk2VSLsUYrK8gTTI2nEEAomGDiMZXQx87cvmj5IrOLDaVKiJxrSoDvhxp2dOAp2SF1oWZ9VoLg4qtIPdIUehNUFCXIWrVGxmewV6KjYrURGAdKZHSJbCjAubjYMWIRzCBAX3HOST2ZnCPH6lXw2GpefCtN1HYugwh0+bAdzDsieDC0geSuFg+lzo8Z4Xubu5JZch15KMbjMcZqTrm3tnJqYfKlG9yhuEopJ1Q94KyDZ4MR0CUc7TxPLDSBZ53dbzTrbfHb98UocUqBHgAO6bkU3VYdtFNRrVKqTJyZq4p8ET/k1mzv+vyyW7bHY4ZzLimOr7doUK5+aVhB3gx5/gD9eM492mYb3SP9uxSZlrejt78ugn6P8E7gEKzMex9pcN5ucDUOb55Tg0HqBp14XoFDzmTCd4DcaFVuvfjeytGBF/2s0uxvffifMOQ9+ZKTarIbYyi25Kxcrys747sxRowtICKZgV3BWF+uMXh1AE0zrY6yvLO8yjznQ4m56rwuRqRfg6iEM+qmaHtb0G+9vL+Ir28n3v/7sfas/trn+6WHHrcvbmfSz/uZ9yD+2n33X5yvbafVn/tp9ZT+2sf7SITnmbv7MfXL/trj+x765H9tS/2PfbFfuq9sB9r/+uvPa9bcOeR97l+kr2tn0g/66fdw/rR9K3eRMivwUChq2dYYGD08MfNQEckqvuWH/mZAgr/QWMf6nYkau/B1839gT76p5vKKFKd3ejYGFGtPemmZCRsyOIoZOYTzITp3IYtw/TDzoM1COI/R9iILaBbhU062bcv0jUKfQqLeUl4Kz6yrQs0fkifjwnpf+kk5Wb0OC699PA4HHHcJDb1ncri6MyRwrAJLRZ9sUMf+nVy00C6mR8Ki6Gr+tE0pUlhYHX0tWA9zpD73K1k0aDzzumtIyNz0azHctsxLHCrRe7kER0z8LuefheDOtWyCKJkOrQr4BA/6nv+FG9RBd5h1S+Kt+pXDtYICq9SQKD1O+BDnx7o6yHxSexIyMFg7hopRanDS344Bn7ZYhm2vMM43BSDYNjb3tm9XUCOcQRMhNHhhoyu5ogSj2+9A5wpeigBr8YRVI0Q4u8zVprWO6a69uFbp9uBoRG0oYi3gzEEmednhtRCekuw2oqxA20sgsswlrTGWwFTL/jOC21hudFT/RYK7fa32kKFCSct1nLi1OOzzxt2wTJW3+0wCo/Wjq/VwjAJPpGsKr1wpD/XLC/+jewO3B+jiNvBk1Lg33CFZ1i5pc+a2doTejtmeJtGJ3zT1AVcoVF3o1x8paBEeHegcj/mxzpmOQyrf6WWaQ2gUOPMDo00nbOgZoRaerMd0PnBqW6CoDjP3h+9f+39jK3ZEm8sJqhkM/lDBZfCRn/HZn+LPrc6nVHwteTi/mvl9mf+VDPIcXyRuNKqtgXqkad1jSOg+H2teKp9483hqZsprBu5Zb4MMv9mHPnqOU51EymfnWKImH2zVMozMd3bmiW9eWoKRbD0EIMkiaSIW7L3wnKEEmrstFfhJpk/mIZRFWR1Rs3uvdZ7edTrvlprhw7m+iAEN86lHhE8N6ldB7fhkuWpzIPL9shoKFzNL74xEvhpOsC6HDld7Ss5/MX9rmZc+7uxuYoGlB3Uc6Xwdq1qX7pTsxaQnk27TpKh35Ldt3DU4QAMyJnFtaCm4XBpkD4ApI/HR1VA5DLj4f/SQNkRq8BAkpbLwVhXr2kAVnJSFgeoB6zL0UaI//c//5upcjVVlJQG/9vCe4Xzcx+2oAmWReNn1/62NjNNam+DgaooU/U/PgN7cHg7uNUjDxMUhXgXky9X6uy4DbI3hAeSm3HJ810csB23ATCdiV1Mo6WT7AzcAPoO02xewGbYO8HW26GLw+Vx1Z6nthe74X0wX9SMq360W53xs+u2Jjv2bPuS/NzWElYQfBukfIs1rCj+I4mST6HYFNM8wboiyZXrL/0n/4p3JfTLjec+5zmHAXeeq9QM5RoGCg8zZNOJo3rO54OnYkrJDAdt+kRW3S4nFwYBp75SPcxwODu4NwKr1lF5v0tRyOtVcTOqBa8MMfHblmgZTrmcQC7SHGvysinLA3Gj9zGnFJujQgqbnogUEMfMC3AHOM2I5k3m5CVwp1b6Aj92VN4qoUbJCSKiJsQZBxUcf+AnlHhhK1eKKKe8owJKlKWAsd3AmXoWqoBrGGo4DfLZGUnRKmbtqmHQcjW03QZ2bnEpgP0uMyXD1h3IG3eAdnJWZ4TM75qLSUO+Iwuwa03jGCc6jOvxmKbRfNA//nriXVKrcvSeCJySVsLkNqYH07R0e1L03Bqg/n4paRlY+q5FZkRcebkY9oGH/7ocR4rhbMZ5mcBeJlJ1WaKUWeE7F3STwqbn/TyZhMEtrFN5jEOlV2/nJ47ljWSu23fDJ9KOOnouxN0ky/BAQFUs1WdQfh1uQTTNcpm2wq6xDIdFzy2vyrkPnJ6oMFKzkE3HKjimYy5gdCajQqgWWV6tLbG9m5cf1HCENDi90zRWJRccKjRMTjdBBUdhUXTl75BWiy+gtizG/iJvzKx/kibzS0G3rned+OGdSQ7ufVM05kw8Y+LpMkXjoxji1G22QuiTBig8oepaGLSYs0LLi3n2DubKmAJ6blUPM7H4VOZcNc2V2ICCurBhPPW3VyDNPjURN1TqTNFkcMKO5bVYZ3TM0k8t9xnvLBCRHPbdxOCZkP9JZb+XBCOzRbTRK0llRqlTRCahQgo/87jqwLBeRWDhijjvU8aXDdRaWKTfqPFUNWtmJGqIIQaGTSizhzVXx6OcsNFfYanLj6Lym4YCQrMI9ls14RoR4OMHot+IAsy3jC7qWMT3nVJE+SVFOUyzZfHoZxrU40HZUCOd39GGHvwSRShvKcXTYQL48C4U2+9HLTDkDYnORxlXFjbc6TH17i5cMEmrXzeNtfqpDT6oYhAdHLmw8QB/ZHhFa9NunnfhJz9P8ERCL6c+blphvCzm/U5lbT0eVG3hZuXi2TiDvxVnutHi+o6NnmED+IM8T8PBNOfMTrryVrmVuAQd38qBQce9JoapyeXEaOeirTynx10qYOviWMhXd/Tc1EkIrCnfNCcix5UmEoXWBA7pVBRoMWCHXCvIM0JhqbsUk4mM5bAK2LkQnhPsmaq/dRt10/KR3hxwTlU0pAtK1yNQZZKKv2kjrIbocNGzJKMwivzt4AnuCPRFrBdBMxJ4RqF2ycUn4ZAHMsXQLGoIph54FlzKsVhwUmiMEjhPDLGOHGaN10O++8j3FpBYVaNAYsebxhHXo01oe0MQfe5GgluK9eCKOjDJZTSr8nsP03wmsUIAhhkTsSWtl+RUggK+NYH84LbiNSXq+Csw2OwKaVKEukiYL4yuXYhlv+qiY3a8u5Er1FGZLOOKxfXzMPolpXourIkZRgPoVWwKMyGwpHk4roM556QsCaXibk4mUDIyJaNTzOGJq8AHyfBmIbA/wgBsmTrgeNeibER07Yr18F0zAVD6BEbQgsfyYTzUNOOQyl9Qta8xHYXLMJRP08d4SjSd2Wx6q0qqlNSFHbCdjcQNSZa8GLkIix67CeZyTYUCTMV7St+QaccbiSkdRXmXYZYno1SMG7HCojUVrAoVWm9F6WeseePaDu144YTTz8mLjzBCAy+o0gXeiYGM4IajRQdTEbOQr00broFXGTB0wje6eslw0kgFD1XQZ0ZEhsm0eG90myKnbLoCGh2khBcVf1FoPuOoDvpxMY15ymM0yTFeuVCNH45u4WnpqBRAUVBanj3O6qjS+7G0rrA9MpxggpwSyP8HUl9uOQ=="
 }